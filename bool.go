@@ -0,0 +1,95 @@
+package into
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// CanBool returns true if the given value can be coerced to a bool.
+// [Bool] will succeed without panicking if CanBool returns true.
+//
+// See: [Bool] for supported types.
+func CanBool(x any, options ...Option) bool {
+	_, err := toBool(x, options)
+	return err == nil
+}
+
+// Bool coerces x into a bool, supporting the following types:
+//   - bool, *bool
+//   - types with an underlying bool value or pointers to such types
+//   - given [WithConvertStrings], any string-like type supported by [String], parsed by [strconv.ParseBool]
+//   - nil
+//
+// Bool will panic with ErrInvalid if the value cannot be coerced.
+func Bool(x any, options ...Option) bool {
+	v, err := toBool(x, options)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// BoolOK is like [Bool], but reports whether the conversion succeeded instead of panicking.
+func BoolOK(x any, options ...Option) (bool, bool) {
+	v, err := toBool(x, options)
+	return v, err == nil
+}
+
+// BoolErr is like [Bool], but returns an error instead of panicking.
+func BoolErr(x any, options ...Option) (bool, error) {
+	return toBool(x, options)
+}
+
+func toBool(x any, options []Option) (bool, error) {
+	switch x := x.(type) {
+	case bool:
+		return x, nil
+	case *bool:
+		if x == nil {
+			goto fallback
+		}
+		return *x, nil
+	case nil:
+		goto fallback
+	}
+
+	if !should(options, skipReflect) {
+		b, ok, err := boolConverters.convert(x)
+		if err == errNil {
+			goto fallback
+		}
+		if ok {
+			return b, nil
+		}
+	}
+
+	if should(options, convertStrings) && CanString(x) {
+		str, err := stringFor(x, options)
+		if err != nil {
+			return false, err
+		}
+		if str == "" {
+			goto fallback
+		}
+		b, err := strconv.ParseBool(str)
+		if err != nil {
+			return false, ErrInvalid{Value: x, Type: "bool", Cause: err}
+		}
+		return b, nil
+	}
+
+	return false, ErrInvalid{Value: x, Type: "bool"}
+
+fallback:
+	return fallbackFor[bool](options, "bool")
+}
+
+var boolConverters = newConverterCache(boolKind)
+
+// boolKind converts rv if it has an underlying bool type, reporting false otherwise.
+func boolKind(rv reflect.Value) (bool, bool, error) {
+	if rv.Kind() == reflect.Bool {
+		return rv.Bool(), true, nil
+	}
+	return false, false, nil
+}
@@ -0,0 +1,78 @@
+package into_test
+
+import (
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+type myBool bool
+
+func TestBool(t *testing.T) {
+	t.Parallel()
+	tests := table[bool]{
+		{
+			name:  "bool",
+			input: true,
+			want:  true,
+		},
+		{
+			name:  "*bool",
+			input: into.Ptr(true),
+			want:  true,
+		},
+		{
+			name:  "*bool(nil)",
+			input: (*bool)(nil),
+			want:  false,
+		},
+		{
+			name:  "subtype",
+			input: myBool(true),
+			want:  true,
+		},
+		{
+			name:  "subtype pointer",
+			input: into.Ptr(myBool(true)),
+			want:  true,
+		},
+		{
+			name:  "subtype without reflection",
+			input: myBool(true),
+			opts:  []into.Option{into.WithoutReflection()},
+			err:   into.ErrInvalid{Value: myBool(true), Type: "bool"},
+		},
+		{
+			name:  "fallback",
+			input: nil,
+			want:  true,
+			opts:  []into.Option{into.WithFallback(true)},
+		},
+		{
+			name:  "string",
+			input: "true",
+			want:  true,
+			opts:  []into.Option{into.WithConvertStrings()},
+		},
+		{
+			name:  "string without conversion",
+			input: "true",
+			err:   into.ErrInvalid{Value: "true", Type: "bool"},
+		},
+		{
+			name:  "bad string",
+			input: "yes",
+			opts:  []into.Option{into.WithConvertStrings()},
+			err:   into.ErrInvalid{Value: "yes", Type: "bool"},
+		},
+		{
+			name:  "int",
+			input: 1,
+			err:   into.ErrInvalid{Value: 1, Type: "bool"},
+		},
+	}
+	tests.Run(t, func(x any, opts ...into.Option) bool {
+		return into.Bool(x, opts...)
+	})
+	tests.RunErr(t, into.BoolErr)
+}
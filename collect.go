@@ -0,0 +1,81 @@
+package into
+
+import "reflect"
+
+// Collector runs many conversions, recording failures instead of panicking.
+// Use [Collect] to create one, and [Collector.Err] to retrieve the errors.
+// A Collector is not safe for concurrent use.
+type Collector struct {
+	errs *Errors
+	path string
+}
+
+// Collect returns a new [Collector].
+//
+//	c := into.Collect()
+//	a := c.Int(m["a"])
+//	b := c.At("b").Float(m["b"])
+//	if err := c.Err(); err != nil {
+//		// ...
+//	}
+func Collect() *Collector {
+	return &Collector{errs: new(Errors)}
+}
+
+// At returns a view of c that records errors at the given key (a string) or index (an int),
+// relative to c's own location. Errors recorded by the view are shared with c.
+func (c *Collector) At(key any) *Collector {
+	return &Collector{errs: c.errs, path: joinPaths(c.path, pathKey(key))}
+}
+
+// Err returns the recorded errors as [Errors], or nil if every conversion succeeded.
+func (c *Collector) Err() error {
+	return c.errs.err()
+}
+
+// String coerces x with [String], returning the fallback value or zero value and recording the error if it fails.
+func (c *Collector) String(x any, options ...Option) string {
+	return Attempt(c, String, x, options...)
+}
+
+// Int coerces x with [Int], returning the fallback value or zero value and recording the error if it fails.
+func (c *Collector) Int(x any, options ...Option) int {
+	return Attempt(c, Int, x, options...)
+}
+
+// Uint coerces x with [Uint], returning the fallback value or zero value and recording the error if it fails.
+func (c *Collector) Uint(x any, options ...Option) uint {
+	return Attempt(c, Uint, x, options...)
+}
+
+// Float coerces x with [Float], returning the fallback value or zero value and recording the error if it fails.
+func (c *Collector) Float(x any, options ...Option) float64 {
+	return Attempt(c, Float, x, options...)
+}
+
+// Attempt coerces x with conv (such as [Addr] or [URL]),
+// returning the fallback value or zero value and recording the error in c if it fails.
+func Attempt[T any](c *Collector, conv func(any, ...Option) T, x any, options ...Option) T {
+	v, err := Maybe(conv, x, options...)
+	if err == nil {
+		return v
+	}
+	if isPanic(err) {
+		// a bug, not a coercion failure
+		panic(err)
+	}
+	invalid, ok := err.(ErrInvalid)
+	if !ok {
+		invalid = ErrInvalid{Value: x, Type: reflect.TypeOf((*T)(nil)).Elem().String(), Cause: err}
+	}
+	invalid.Path = joinPaths(c.path, invalid.Path)
+	*c.errs = append(*c.errs, invalid)
+
+	for _, opt := range options {
+		if opt, ok := opt.(fallbackValue); ok {
+			v, _ = opt.x.(T)
+			break
+		}
+	}
+	return v
+}
@@ -0,0 +1,80 @@
+package into_test
+
+import (
+	"errors"
+	"net/netip"
+	"strconv"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+	row := map[string]any{
+		"id":    "42",
+		"name":  "cat",
+		"score": "high",
+		"count": -1,
+		"addr":  "nope",
+	}
+	c := into.Collect()
+	id := c.At("id").Int(row["id"], into.WithConvertStrings())
+	name := c.At("name").String(row["name"])
+	score := c.At("score").Float(row["score"], into.WithConvertStrings(), into.WithFallback(0.5))
+	count := c.At("count").Uint(row["count"])
+	addr := into.Attempt(c.At("addr"), into.Addr, row["addr"], into.WithConvertStrings())
+
+	if id != 42 || name != "cat" {
+		t.Error("bad successful values:", id, name)
+	}
+	if score != 0.5 {
+		t.Error("failed conversion should return fallback. got:", score)
+	}
+	if count != 0 || addr != (netip.Addr{}) {
+		t.Error("failed conversion should return zero value. got:", count, addr)
+	}
+
+	err := c.Err()
+	var errs into.Errors
+	if !errors.As(err, &errs) {
+		t.Fatal("expected into.Errors, got:", err)
+	}
+	if len(errs) != 3 {
+		t.Fatal("expected 3 errors, got:", errs)
+	}
+	for i, path := range []string{"score", "count", "addr"} {
+		if errs[i].Path != path {
+			t.Error("bad path. want:", path, "got:", errs[i].Path)
+		}
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Error("errors.Is should see through into.Errors")
+	}
+	var invalid into.ErrInvalid
+	if !errors.As(err, &invalid) || invalid.Path != "score" {
+		t.Error("errors.As should find the first ErrInvalid. got:", invalid)
+	}
+}
+
+func TestCollectNested(t *testing.T) {
+	t.Parallel()
+	c := into.Collect()
+	items := c.At("items")
+	for i, v := range []any{1, "x", 3} {
+		items.At(i).Int(v)
+	}
+	if got := into.ErrorPath(c.Err()); got != "items[1]" {
+		t.Error("bad path:", got)
+	}
+}
+
+func TestCollectNoErrors(t *testing.T) {
+	t.Parallel()
+	c := into.Collect()
+	c.Int(1)
+	c.String("a")
+	if err := c.Err(); err != nil {
+		t.Error("unexpected error:", err)
+	}
+}
@@ -0,0 +1,227 @@
+package into
+
+import (
+	"cmp"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Equal reports whether a and b are equal after coercion, as defined by [Compare].
+// Values that Compare does not support, such as structs, are compared with [reflect.DeepEqual] instead.
+//
+// For example, 5, int64(5), 5.0, and myInt(5) are all equal,
+// and given [WithConvertStrings], so is "5".
+func Equal(a, b any, options ...Option) bool {
+	c, err := Compare(a, b, options...)
+	if err != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return c == 0
+}
+
+// Compare returns -1 if a is less than b, 0 if they are equal, and +1 if a is greater than b.
+// Operands are coerced as follows:
+//   - numbers of any kind (including named types and pointers) are compared by value, without loss of precision;
+//     NaN is less than any other number and equal to itself
+//   - string-like values are compared with other strings, and bools with other bools (false is less than true)
+//   - nil (or a nil pointer) is equal to nil and less than any other value
+//
+// By default, comparison is strict: a string is never equal to a number.
+// Given [WithConvertStrings], comparison is loose: strings are parsed when compared to numbers or bools.
+//
+// Compare returns ErrInvalid if a and b are not comparable.
+func Compare(a, b any, options ...Option) (int, error) {
+	x, ok := operand(a)
+	if !ok {
+		return 0, ErrInvalid{Value: a, Type: "comparable value"}
+	}
+	y, ok := operand(b)
+	if !ok {
+		return 0, ErrInvalid{Value: b, Type: "comparable value"}
+	}
+	if c, ok := compareScalars(x, y, should(options, convertStrings)); ok {
+		return c, nil
+	}
+	return 0, ErrInvalid{Value: b, Type: kindName(x.kind)}
+}
+
+// SortAny sorts xs in place, for slices of mixed types.
+// Values are ordered by kind (nil, bools, numbers, then strings) and then as defined by [Compare] without options.
+// Values that Compare does not support are placed at the end.
+// The sort is stable, so equal values such as 5 and 5.0 keep their original order.
+func SortAny(xs []any) {
+	slices.SortStableFunc(xs, func(a, b any) int {
+		x, okx := operand(a)
+		y, oky := operand(b)
+		if c := cmp.Compare(rank(x, okx), rank(y, oky)); c != 0 || !okx {
+			return c
+		}
+		c, _ := compareScalars(x, y, false)
+		return c
+	})
+}
+
+// operand returns x as a scalar for comparison, with reflect.Invalid as its kind for nil.
+// It reports false if x is not a number, string, or bool.
+func operand(x any) (scalar, bool) {
+	switch x := x.(type) {
+	case nil:
+		return scalar{}, true
+	case int:
+		return intScalar(int64(x)), true
+	case float64:
+		return floatScalar(x), true
+	case string:
+		return scalar{kind: reflect.String, str: x}, true
+	case bool:
+		return boolScalar(x), true
+	}
+	rv, ok := indirect(reflect.ValueOf(x))
+	if !ok {
+		return scalar{}, true
+	}
+	if str, ok, err := stringKind(rv); ok {
+		if err == errNil {
+			return scalar{}, true
+		}
+		return scalar{kind: reflect.String, str: str}, true
+	}
+	v := scalarValue(rv)
+	return v, v.kind != reflect.Invalid
+}
+
+func rank(x scalar, ok bool) int {
+	if !ok {
+		return 4
+	}
+	switch x.kind {
+	case reflect.Invalid:
+		return 0
+	case reflect.Bool:
+		return 1
+	case reflect.String:
+		return 3
+	}
+	return 2
+}
+
+func kindName(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Invalid:
+		return "nil"
+	case reflect.Bool:
+		return "bool"
+	case reflect.String:
+		return "string"
+	}
+	return "number"
+}
+
+// compareScalars compares x and y, reporting false if they are not comparable.
+// If parse is true, strings are parsed when compared to numbers or bools.
+func compareScalars(x, y scalar, parse bool) (int, bool) {
+	switch {
+	case x.kind == reflect.Invalid || y.kind == reflect.Invalid:
+		return cmp.Compare(rank(x, true), rank(y, true)), true
+	case x.kind == reflect.String && y.kind == reflect.String:
+		return strings.Compare(x.str, y.str), true
+	case x.kind == reflect.Bool && y.kind == reflect.Bool:
+		return cmp.Compare(x.n, y.n), true
+	case x.kind == reflect.Bool || y.kind == reflect.Bool:
+		if !parse {
+			return 0, false
+		}
+		if x.kind == reflect.String {
+			b, err := strconv.ParseBool(x.str)
+			return cmp.Compare(boolScalar(b).n, y.n), err == nil
+		}
+		if y.kind == reflect.String {
+			b, err := strconv.ParseBool(y.str)
+			return cmp.Compare(x.n, boolScalar(b).n), err == nil
+		}
+		return 0, false
+	case x.kind == reflect.String:
+		n, ok := parseNumber(x.str)
+		if !parse || !ok {
+			return 0, false
+		}
+		return compareNumbers(n, y), true
+	case y.kind == reflect.String:
+		n, ok := parseNumber(y.str)
+		if !parse || !ok {
+			return 0, false
+		}
+		return compareNumbers(x, n), true
+	}
+	return compareNumbers(x, y), true
+}
+
+// parseNumber parses str as an integer if possible, otherwise as a float.
+func parseNumber(str string) (scalar, bool) {
+	if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+		return intScalar(n), true
+	}
+	if n, err := strconv.ParseUint(str, 10, 64); err == nil {
+		return uintScalar(n), true
+	}
+	if f, err := strconv.ParseFloat(str, 64); err == nil {
+		return floatScalar(f), true
+	}
+	return scalar{}, false
+}
+
+// compareNumbers compares two numeric scalars exactly, even across kinds.
+func compareNumbers(x, y scalar) int {
+	switch {
+	case x.kind == y.kind && x.kind == reflect.Int64:
+		return cmp.Compare(x.i(), y.i())
+	case x.kind == y.kind && x.kind == reflect.Uint64:
+		return cmp.Compare(x.n, y.n)
+	case x.kind == y.kind:
+		return cmp.Compare(x.f(), y.f())
+	case x.kind == reflect.Float64:
+		return -compareNumbers(y, x)
+	case y.kind == reflect.Float64:
+		return compareIntFloat(x, y.f())
+	case x.kind == reflect.Int64: // and y is a uint
+		if x.i() < 0 {
+			return -1
+		}
+		return cmp.Compare(uint64(x.i()), y.n)
+	}
+	return -compareNumbers(y, x)
+}
+
+// compareIntFloat compares the integer x with f, without converting x to a float.
+func compareIntFloat(x scalar, f float64) int {
+	switch {
+	case math.IsNaN(f):
+		return 1
+	case f < math.MinInt64:
+		return 1
+	case f >= math.MaxUint64:
+		return -1
+	}
+	t := math.Trunc(f)
+	var c int
+	switch {
+	case x.kind == reflect.Int64 && t < math.MaxInt64:
+		c = cmp.Compare(x.i(), int64(t))
+	case x.kind == reflect.Int64:
+		c = -1
+	case t < 0:
+		c = 1
+	default:
+		c = cmp.Compare(x.n, uint64(t))
+	}
+	if c == 0 && f != t {
+		if f > t {
+			return -1
+		}
+		return 1
+	}
+	return c
+}
@@ -0,0 +1,97 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+	five := 5
+	var nilInt *int
+	table := []struct {
+		name    string
+		a, b    any
+		options []into.Option
+		want    int
+		fail    bool
+	}{
+		{name: "ints", a: 1, b: 2, want: -1},
+		{name: "widening", a: int8(5), b: uint64(5), want: 0},
+		{name: "int float", a: 5, b: 5.0, want: 0},
+		{name: "int fraction", a: 5, b: 5.5, want: -1},
+		{name: "negative fraction", a: -5, b: -5.5, want: 1},
+		{name: "negative uint", a: -1, b: uint64(math.MaxUint64), want: -1},
+		{name: "large uint float", a: uint64(math.MaxUint64), b: float64(math.MaxInt64), want: 1},
+		{name: "precision", a: int64(1<<53 + 1), b: float64(1 << 53), want: 1},
+		{name: "NaN", a: math.NaN(), b: 0, want: -1},
+		{name: "named", a: myInt(5), b: &five, want: 0},
+		{name: "strings", a: "a", b: myString("b"), want: -1},
+		{name: "bytes", a: []byte("abc"), b: "abc", want: 0},
+		{name: "bools", a: false, b: true, want: -1},
+		{name: "nils", a: nil, b: nilInt, want: 0},
+		{name: "nil first", a: nil, b: -1, want: -1},
+		{name: "strict", a: "5", b: 5, fail: true},
+		{name: "loose", a: "5", b: 5, options: []into.Option{into.WithConvertStrings()}, want: 0},
+		{name: "loose float", a: 10, b: "9.5", options: []into.Option{into.WithConvertStrings()}, want: 1},
+		{name: "loose bool", a: true, b: "true", options: []into.Option{into.WithConvertStrings()}, want: 0},
+		{name: "loose bad", a: 5, b: "cat", options: []into.Option{into.WithConvertStrings()}, fail: true},
+		{name: "bool number", a: true, b: 1, fail: true},
+		{name: "unsupported", a: struct{}{}, b: 1, fail: true},
+	}
+	for _, test := range table {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := into.Compare(test.a, test.b, test.options...)
+			if test.fail {
+				if !errors.As(err, &into.ErrInvalid{}) {
+					t.Errorf("want ErrInvalid, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if got != test.want {
+				t.Errorf("Compare(%v, %v) = %d, want %d", test.a, test.b, got, test.want)
+			}
+			if rev, _ := into.Compare(test.b, test.a, test.options...); rev != -test.want {
+				t.Errorf("Compare(%v, %v) = %d, want %d", test.b, test.a, rev, -test.want)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+	if !into.Equal(5, 5.0) {
+		t.Error("5 should equal 5.0")
+	}
+	if into.Equal("5", 5) {
+		t.Error("strict: \"5\" should not equal 5")
+	}
+	if !into.Equal("5", 5, into.WithConvertStrings()) {
+		t.Error("loose: \"5\" should equal 5")
+	}
+	if !into.Equal([]int{1, 2}, []int{1, 2}) {
+		t.Error("unsupported types should use DeepEqual")
+	}
+	if into.Equal(nil, 0) {
+		t.Error("nil should not equal 0")
+	}
+}
+
+func TestSortAny(t *testing.T) {
+	t.Parallel()
+	s := struct{}{}
+	xs := []any{"b", 3, s, 2.5, nil, true, int8(3), "a", false, uint(1)}
+	into.SortAny(xs)
+	want := []any{nil, false, true, uint(1), 2.5, 3, int8(3), "a", "b", s}
+	if !reflect.DeepEqual(xs, want) {
+		t.Errorf("bad sort. want: %v got: %v", want, xs)
+	}
+}
@@ -0,0 +1,362 @@
+package into
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int64 | ~int32 | ~int16 | ~int8 |
+		~uint | ~uint64 | ~uint32 | ~uint16 | ~uint8 | ~uintptr |
+		~float64 | ~float32
+}
+
+// Scalar is a constraint that permits any number, string, or bool type.
+type Scalar interface {
+	Number | ~string | ~bool
+}
+
+// Convert converts s, whose type is known at compile time, to T without boxing it into an interface.
+// It follows the same rules as the dynamic coercers:
+//   - numbers convert to other numbers if they fit; floats convert to integers only if they have no fractional part
+//   - given [WithConvertStrings], strings are parsed into numbers or bools, and empty strings produce the fallback value
+//   - strings convert to strings, and bools to bools
+//
+// Numbers and bools are never converted to strings; use [strconv] for that.
+// Convert returns ErrInvalid if s cannot be converted.
+func Convert[T, S Scalar](s S, options ...Option) (T, error) {
+	// Converting signed integers to int is the common case, so it skips the scalar.
+	// Each assertion is resolved with a single comparison against the instantiation's dictionary.
+	var t T
+	if p, ok := any(&t).(*int); ok {
+		if q, ok := any(&s).(*int); ok {
+			*p = *q
+			return t, nil
+		}
+		if q, ok := any(&s).(*int32); ok {
+			*p = int(*q)
+			return t, nil
+		}
+		if q, ok := any(&s).(*int16); ok {
+			*p = int(*q)
+			return t, nil
+		}
+		if q, ok := any(&s).(*int8); ok {
+			*p = int(*q)
+			return t, nil
+		}
+	}
+	return convert[T](s, options)
+}
+
+func convert[T, S Scalar](s S, options []Option) (T, error) {
+	var t T
+	v := scalarOf(&s)
+	if v.kind == reflect.String && should(options, convertStrings) {
+		v.n = 1
+	}
+	switch err := setScalar(&t, v); err {
+	case nil:
+		return t, nil
+	case errEmpty:
+		return fallbackFor[T](options, reflect.TypeOf(t).String())
+	case ErrUnsupportedType:
+		var zero T
+		return zero, ErrInvalid{Value: s, Type: reflect.TypeOf(t).String()}
+	default:
+		var zero T
+		return zero, ErrInvalid{Value: s, Type: reflect.TypeOf(t).String(), Cause: err}
+	}
+}
+
+// scalar is an unboxed number, string, or bool.
+// Numbers and bools are stored in n, and strings in str.
+// For strings, n is nonzero if they may be parsed into numbers and bools, as with [WithConvertStrings].
+// It is kept small enough to be passed in registers.
+type scalar struct {
+	kind reflect.Kind // one of reflect.Int64, reflect.Uint64, reflect.Float64, reflect.String, or reflect.Bool
+	n    uint64
+	str  string
+}
+
+func intScalar(n int64) scalar     { return scalar{kind: reflect.Int64, n: uint64(n)} }
+func uintScalar(n uint64) scalar   { return scalar{kind: reflect.Uint64, n: n} }
+func floatScalar(f float64) scalar { return scalar{kind: reflect.Float64, n: math.Float64bits(f)} }
+func boolScalar(b bool) scalar {
+	if b {
+		return scalar{kind: reflect.Bool, n: 1}
+	}
+	return scalar{kind: reflect.Bool}
+}
+
+func (v scalar) i() int64   { return int64(v.n) }
+func (v scalar) f() float64 { return math.Float64frombits(v.n) }
+
+// errEmpty means an empty string was given where a number or bool was expected, calling for the fallback value.
+var errEmpty = errors.New("into: empty string")
+
+// scalarOf reads *s. It switches on the type of the pointer, which needs no allocation,
+// and falls back to reflection for named types.
+func scalarOf[S Scalar](s *S) scalar {
+	switch p := any(s).(type) {
+	case *int:
+		return intScalar(int64(*p))
+	case *int64:
+		return intScalar(*p)
+	case *int32:
+		return intScalar(int64(*p))
+	case *int16:
+		return intScalar(int64(*p))
+	case *int8:
+		return intScalar(int64(*p))
+	case *uint:
+		return uintScalar(uint64(*p))
+	case *uint64:
+		return uintScalar(*p)
+	case *uint32:
+		return uintScalar(uint64(*p))
+	case *uint16:
+		return uintScalar(uint64(*p))
+	case *uint8:
+		return uintScalar(uint64(*p))
+	case *float64:
+		return floatScalar(*p)
+	case *float32:
+		return floatScalar(float64(*p))
+	case *string:
+		return scalar{kind: reflect.String, str: *p}
+	case *bool:
+		return boolScalar(*p)
+	}
+	return scalarValue(reflect.ValueOf(s).Elem())
+}
+
+func scalarValue(rv reflect.Value) scalar {
+	switch {
+	case rv.CanInt():
+		return intScalar(rv.Int())
+	case rv.CanUint():
+		return uintScalar(rv.Uint())
+	case rv.CanFloat():
+		return floatScalar(rv.Float())
+	case rv.Kind() == reflect.String:
+		return scalar{kind: reflect.String, str: rv.String()}
+	case rv.Kind() == reflect.Bool:
+		return boolScalar(rv.Bool())
+	}
+	return scalar{}
+}
+
+// setScalar assigns v to *t, returning the cause of failure.
+func setScalar[T Scalar](t *T, v scalar) (err error) {
+	switch p := any(t).(type) {
+	case *int:
+		var n int64
+		n, err = v.int(strconv.IntSize)
+		*p = int(n)
+	case *int64:
+		*p, err = v.int(64)
+	case *int32:
+		var n int64
+		n, err = v.int(32)
+		*p = int32(n)
+	case *int16:
+		var n int64
+		n, err = v.int(16)
+		*p = int16(n)
+	case *int8:
+		var n int64
+		n, err = v.int(8)
+		*p = int8(n)
+	case *uint:
+		var n uint64
+		n, err = v.uint(strconv.IntSize)
+		*p = uint(n)
+	case *uint64:
+		*p, err = v.uint(64)
+	case *uint32:
+		var n uint64
+		n, err = v.uint(32)
+		*p = uint32(n)
+	case *uint16:
+		var n uint64
+		n, err = v.uint(16)
+		*p = uint16(n)
+	case *uint8:
+		var n uint64
+		n, err = v.uint(8)
+		*p = uint8(n)
+	case *float64:
+		*p, err = v.float(64)
+	case *float32:
+		var f float64
+		f, err = v.float(32)
+		*p = float32(f)
+	case *string:
+		*p, err = v.string()
+	case *bool:
+		*p, err = v.bool()
+	default:
+		err = v.set(reflect.ValueOf(t).Elem())
+	}
+	return err
+}
+
+// set assigns v to rv, which must be a number, string, or bool.
+// It returns the cause of failure, leaving rv unchanged.
+func (v scalar) set(rv reflect.Value) error {
+	switch {
+	case rv.CanInt():
+		n, err := v.int(rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+	case rv.CanUint():
+		n, err := v.uint(rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+	case rv.CanFloat():
+		f, err := v.float(rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	case rv.Kind() == reflect.String:
+		str, err := v.string()
+		if err != nil {
+			return err
+		}
+		rv.SetString(str)
+	case rv.Kind() == reflect.Bool:
+		b, err := v.bool()
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	default:
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+func (v scalar) int(bits int) (int64, error) {
+	min, max := int64(math.MinInt64)>>(64-bits), int64(math.MaxInt64)>>(64-bits)
+	switch v.kind {
+	case reflect.Int64:
+		n := v.i()
+		if n < min || n > max {
+			return 0, ErrRange
+		}
+		return n, nil
+	case reflect.Uint64:
+		if v.n > uint64(max) {
+			return 0, ErrRange
+		}
+		return int64(v.n), nil
+	case reflect.Float64:
+		f := v.f()
+		if f != math.Trunc(f) || math.IsInf(f, 0) {
+			return 0, fmt.Errorf("%w: %v is not an integer", ErrLossy, f)
+		}
+		if f < float64(min) || f >= -float64(min) {
+			return 0, ErrRange
+		}
+		return int64(f), nil
+	case reflect.String:
+		if err := v.parsable(); err != nil {
+			return 0, err
+		}
+		return strconv.ParseInt(v.str, 10, bits)
+	}
+	return 0, ErrUnsupportedType
+}
+
+func (v scalar) uint(bits int) (uint64, error) {
+	max := uint64(math.MaxUint64) >> (64 - bits)
+	switch v.kind {
+	case reflect.Int64:
+		n := v.i()
+		if n < 0 || uint64(n) > max {
+			return 0, ErrRange
+		}
+		return uint64(n), nil
+	case reflect.Uint64:
+		if v.n > max {
+			return 0, ErrRange
+		}
+		return v.n, nil
+	case reflect.Float64:
+		f := v.f()
+		if f != math.Trunc(f) || math.IsInf(f, 0) {
+			return 0, fmt.Errorf("%w: %v is not an integer", ErrLossy, f)
+		}
+		if f < 0 || f >= float64(max)+1 {
+			return 0, ErrRange
+		}
+		return uint64(f), nil
+	case reflect.String:
+		if err := v.parsable(); err != nil {
+			return 0, err
+		}
+		return strconv.ParseUint(v.str, 10, bits)
+	}
+	return 0, ErrUnsupportedType
+}
+
+func (v scalar) float(bits int) (float64, error) {
+	switch v.kind {
+	case reflect.Int64:
+		return float64(v.i()), nil
+	case reflect.Uint64:
+		return float64(v.n), nil
+	case reflect.Float64:
+		f := v.f()
+		if bits == 32 && math.Abs(f) > math.MaxFloat32 && !math.IsInf(f, 0) {
+			return 0, ErrRange
+		}
+		return f, nil
+	case reflect.String:
+		if err := v.parsable(); err != nil {
+			return 0, err
+		}
+		return strconv.ParseFloat(v.str, bits)
+	}
+	return 0, ErrUnsupportedType
+}
+
+func (v scalar) string() (string, error) {
+	if v.kind != reflect.String {
+		return "", ErrUnsupportedType
+	}
+	return v.str, nil
+}
+
+func (v scalar) bool() (bool, error) {
+	switch v.kind {
+	case reflect.Bool:
+		return v.n != 0, nil
+	case reflect.String:
+		if err := v.parsable(); err != nil {
+			return false, err
+		}
+		return strconv.ParseBool(v.str)
+	}
+	return false, ErrUnsupportedType
+}
+
+func (v scalar) parsable() error {
+	switch {
+	case v.n == 0:
+		return ErrUnsupportedType
+	case v.str == "":
+		return errEmpty
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+	check := func(t *testing.T, got, want any, err error, wantErr error) {
+		t.Helper()
+		if wantErr != nil {
+			if !errors.Is(err, wantErr) {
+				t.Errorf("want error %v, got: %v", wantErr, err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+		if got != want {
+			t.Errorf("bad result. want: %v (%T) got: %v (%T)", want, want, got, got)
+		}
+	}
+
+	t.Run("narrowing", func(t *testing.T) {
+		got, err := into.Convert[int8](int32(100))
+		check(t, got, int8(100), err, nil)
+		_, err = into.Convert[int8](int32(200))
+		check(t, nil, nil, err, into.ErrRange)
+		_, err = into.Convert[uint](-1)
+		check(t, nil, nil, err, into.ErrRange)
+		_, err = into.Convert[int64](uint64(math.MaxUint64))
+		check(t, nil, nil, err, into.ErrRange)
+	})
+	t.Run("integers", func(t *testing.T) {
+		got, err := into.Convert[int64](int8(-128))
+		check(t, got, int64(-128), err, nil)
+		_, err = into.Convert[int16](int64(math.MinInt16 - 1))
+		check(t, nil, nil, err, into.ErrRange)
+		u, err := into.Convert[uint8](uint64(255))
+		check(t, u, uint8(255), err, nil)
+		_, err = into.Convert[uint8](uint64(256))
+		check(t, nil, nil, err, into.ErrRange)
+		p, err := into.Convert[uintptr](uint8(7))
+		check(t, p, uintptr(7), err, nil)
+		n, err := into.Convert[myInt](int16(-5))
+		check(t, n, myInt(-5), err, nil)
+		n, err = into.Convert[myInt](uint16(5))
+		check(t, n, myInt(5), err, nil)
+		i, err := into.Convert[int](int16(-5))
+		check(t, i, -5, err, nil)
+		i, err = into.Convert[int](myInt(5))
+		check(t, i, 5, err, nil)
+		i, err = into.Convert[int](int64(math.MinInt32))
+		check(t, i, math.MinInt32, err, nil)
+	})
+	t.Run("floats", func(t *testing.T) {
+		got, err := into.Convert[int](42.0)
+		check(t, got, 42, err, nil)
+		_, err = into.Convert[int](4.2)
+		check(t, nil, nil, err, into.ErrLossy)
+		f, err := into.Convert[float32](uint8(7))
+		check(t, f, float32(7), err, nil)
+		_, err = into.Convert[float32](math.MaxFloat64)
+		check(t, nil, nil, err, into.ErrRange)
+	})
+	t.Run("named types", func(t *testing.T) {
+		got, err := into.Convert[myInt](myUint(42))
+		check(t, got, myInt(42), err, nil)
+		str, err := into.Convert[string](myString("hello"))
+		check(t, str, "hello", err, nil)
+	})
+	t.Run("strings", func(t *testing.T) {
+		got, err := into.Convert[int16]("-42", into.WithConvertStrings())
+		check(t, got, int16(-42), err, nil)
+		_, err = into.Convert[int8]("300", into.WithConvertStrings())
+		check(t, nil, nil, err, into.ErrRange)
+		_, err = into.Convert[int]("cat", into.WithConvertStrings())
+		check(t, nil, nil, err, into.ErrSyntax)
+		b, err := into.Convert[bool](myString("true"), into.WithConvertStrings())
+		check(t, b, true, err, nil)
+		f, err := into.Convert[float64]("", into.WithConvertStrings(), into.WithFallback(1.5))
+		check(t, f, 1.5, err, nil)
+		_, err = into.Convert[int]("42")
+		check(t, nil, nil, err, into.ErrUnsupportedType)
+	})
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := into.Convert[string](42)
+		check(t, nil, nil, err, into.ErrUnsupportedType)
+		_, err = into.Convert[int](true)
+		check(t, nil, nil, err, into.ErrUnsupportedType)
+	})
+}
+
+func BenchmarkConvert(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		got, err := into.Convert[int](int32(42))
+		if err != nil || got != 42 {
+			b.Fatal("bad result:", got, err)
+		}
+	}
+}
+
+func BenchmarkConvertInt(b *testing.B) {
+	// the dynamic equivalent of BenchmarkConvert
+	for i := 0; i < b.N; i++ {
+		got, err := into.IntErr(int32(42))
+		if err != nil || got != 42 {
+			b.Fatal("bad result:", got, err)
+		}
+	}
+}
+
+func BenchmarkConvertString(b *testing.B) {
+	opt := into.WithConvertStrings()
+	for i := 0; i < b.N; i++ {
+		got, err := into.Convert[int]("42", opt)
+		if err != nil || got != 42 {
+			b.Fatal("bad result:", got, err)
+		}
+	}
+}
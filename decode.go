@@ -0,0 +1,477 @@
+package into
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Decode fills dst, which must be a non-nil pointer, from src.
+// Struct fields are read from maps with string-like keys (such as map[string]any),
+// using [String], [Int], [Uint], [Float] and friends to coerce each value.
+// Nested structs, pointers, slices, arrays, maps, and types implementing [encoding.TextUnmarshaler]
+// or sql.Scanner are supported. Given [WithConvertStrings], strings are parsed into time.Duration with [time.ParseDuration].
+// Numbers are converted between integer and float kinds if no precision is lost,
+// so the float64 values produced by encoding/json can be decoded into integer fields.
+//
+// Field names are taken from the "into" struct tag, then the "json" struct tag, then the field name.
+// Fields of embedded structs are promoted following the rules of encoding/json.
+// Use [WithCaseInsensitive] to match keys case-insensitively. Options are passed along to the coercers,
+// except for [WithFallback].
+//
+// Decode does not stop at the first error: it returns every field error as [Errors],
+// each with its Path set to the field's location.
+func Decode(src any, dst any, options ...Option) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return ErrInvalid{Value: dst, Type: "non-nil pointer"}
+	}
+	d := decoder{options: withoutFallback(options)}
+	d.value(rv.Elem(), src, "")
+	return d.errs.err()
+}
+
+type decoder struct {
+	options []Option
+	errs    Errors
+}
+
+// fail records err, an ErrInvalid, at the given path.
+func (d *decoder) fail(path string, err ErrInvalid) {
+	err.Path = joinPaths(path, err.Path)
+	d.errs = append(d.errs, err)
+}
+
+// try runs fn to decode src into rv, recording any panic as an error at the given path.
+func (d *decoder) try(rv reflect.Value, src any, path string, fn func()) {
+	err := Try(fn)
+	if err == nil {
+		return
+	}
+	if isPanic(err) {
+		// a bug, not a coercion failure
+		panic(err)
+	}
+	invalid, ok := err.(ErrInvalid)
+	if !ok {
+		invalid = ErrInvalid{Value: src, Type: rv.Type().String(), Cause: err}
+	}
+	d.fail(path, invalid)
+}
+
+func (d *decoder) value(rv reflect.Value, src any, path string) {
+	if src == nil {
+		rv.SetZero()
+		return
+	}
+	sv := reflect.ValueOf(src)
+	if sv.Kind() == reflect.Pointer && sv.IsNil() {
+		rv.SetZero()
+		return
+	}
+
+	if rv.Kind() == reflect.Pointer {
+		if sv.Type().AssignableTo(rv.Type()) {
+			rv.Set(sv)
+			return
+		}
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		d.value(rv.Elem(), src, path)
+		return
+	}
+
+	if sv.Type().AssignableTo(rv.Type()) {
+		rv.Set(sv)
+		return
+	}
+
+	if rv.CanAddr() && rv.Addr().Type().Implements(textUnmarshalerType) && CanString(src, d.options...) {
+		d.try(rv, src, path, func() {
+			text, err := stringFor(src, d.options)
+			if err != nil {
+				panic(err)
+			}
+			if err := rv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text)); err != nil {
+				panic(ErrInvalid{Value: src, Type: rv.Type().String(), Cause: kindError{ErrSyntax, err}})
+			}
+		})
+		return
+	}
+
+	if rv.CanAddr() && rv.Addr().Type().Implements(scannerType) {
+		d.try(rv, src, path, func() {
+			if err := rv.Addr().Interface().(scanner).Scan(src); err != nil {
+				panic(ErrInvalid{Value: src, Type: rv.Type().String(), Cause: err})
+			}
+		})
+		return
+	}
+
+	if rv.Type() == durationType && should(d.options, convertStrings) && sv.Kind() == reflect.String {
+		d.try(rv, src, path, func() {
+			dur, err := time.ParseDuration(sv.String())
+			if err != nil {
+				panic(ErrInvalid{Value: src, Type: "time.Duration", Cause: kindError{ErrSyntax, err}})
+			}
+			rv.SetInt(int64(dur))
+		})
+		return
+	}
+
+	for sv.Kind() == reflect.Pointer {
+		sv = sv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		if sv.Type().AssignableTo(rv.Type()) {
+			rv.Set(sv)
+			return
+		}
+	case reflect.String:
+		d.try(rv, src, path, func() {
+			rv.SetString(String(src, d.options...))
+		})
+		return
+	case reflect.Bool:
+		d.try(rv, src, path, func() {
+			rv.SetBool(Bool(src, d.options...))
+		})
+		return
+	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8,
+		reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uintptr,
+		reflect.Float64, reflect.Float32:
+		d.try(rv, src, path, func() {
+			setNumber(rv, src, sv, d.options)
+		})
+		return
+	case reflect.Struct:
+		d.structure(rv, src, sv, path)
+		return
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 && sv.Kind() == reflect.String {
+			rv.SetBytes([]byte(sv.String()))
+			return
+		}
+		if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+			break
+		}
+		if sv.Kind() == reflect.Slice && sv.IsNil() {
+			rv.SetZero()
+			return
+		}
+		out := reflect.MakeSlice(rv.Type(), sv.Len(), sv.Len())
+		for i := 0; i < sv.Len(); i++ {
+			d.value(out.Index(i), sv.Index(i).Interface(), joinPaths(path, pathKey(i)))
+		}
+		rv.Set(out)
+		return
+	case reflect.Array:
+		if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+			break
+		}
+		if sv.Len() > rv.Len() {
+			d.fail(path, ErrInvalid{Value: src, Type: rv.Type().String(), Cause: fmt.Errorf("%w: too many elements (%d)", ErrRange, sv.Len())})
+			return
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if i >= sv.Len() {
+				rv.Index(i).SetZero()
+				continue
+			}
+			d.value(rv.Index(i), sv.Index(i).Interface(), joinPaths(path, pathKey(i)))
+		}
+		return
+	case reflect.Map:
+		if sv.Kind() != reflect.Map {
+			break
+		}
+		if sv.IsNil() {
+			rv.SetZero()
+			return
+		}
+		out := reflect.MakeMapWithSize(rv.Type(), sv.Len())
+		iter := sv.MapRange()
+		for iter.Next() {
+			k, v := iter.Key().Interface(), iter.Value().Interface()
+			at := joinPaths(path, pathKey(k))
+			nerr := len(d.errs)
+			kv := reflect.New(rv.Type().Key()).Elem()
+			d.value(kv, k, at)
+			vv := reflect.New(rv.Type().Elem()).Elem()
+			d.value(vv, v, at)
+			if len(d.errs) == nerr {
+				out.SetMapIndex(kv, vv)
+			}
+		}
+		rv.Set(out)
+		return
+	}
+
+	d.fail(path, ErrInvalid{Value: src, Type: rv.Type().String()})
+}
+
+func (d *decoder) structure(rv reflect.Value, src any, sv reflect.Value, path string) {
+	if sv.Kind() != reflect.Map {
+		d.fail(path, ErrInvalid{Value: src, Type: rv.Type().String()})
+		return
+	}
+	fields, ok := src.(map[string]any)
+	if !ok {
+		fields = make(map[string]any, sv.Len())
+		iter := sv.MapRange()
+		for iter.Next() {
+			if k := iter.Key().Interface(); CanString(k) {
+				fields[String(k)] = iter.Value().Interface()
+			}
+		}
+	}
+
+	for _, field := range planFor(rv.Type()) {
+		v, ok := fields[field.name]
+		if !ok && should(d.options, foldCase) {
+			for k, kv := range fields {
+				if strings.EqualFold(k, field.name) {
+					v, ok = kv, true
+					break
+				}
+			}
+		}
+		if !ok {
+			continue
+		}
+		d.value(fieldByIndex(rv, field.index), v, joinPaths(path, pathKey(field.name)))
+	}
+}
+
+// fieldByIndex is like [reflect.Value.FieldByIndex], but allocates nil embedded struct pointers.
+func fieldByIndex(rv reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(x)
+	}
+	return rv
+}
+
+// setNumber assigns src to the numeric value rv, converting between numeric kinds if no precision is lost.
+func setNumber(rv reflect.Value, src any, sv reflect.Value, options []Option) {
+	invalid := func(cause error) {
+		panic(ErrInvalid{Value: src, Type: rv.Type().String(), Cause: cause})
+	}
+
+	if isNumber(sv.Kind()) {
+		if err := scalarValue(sv).set(rv); err != nil {
+			invalid(err)
+		}
+		return
+	}
+
+	// otherwise, use the coercers (for strings given WithConvertStrings, and so on)
+	var v scalar
+	var err error
+	switch {
+	case rv.CanInt():
+		var n int
+		n, err = toInt(src, options)
+		v = intScalar(int64(n))
+	case rv.CanUint():
+		var n uint
+		n, err = toUint(src, options)
+		v = uintScalar(uint64(n))
+	default:
+		var f float64
+		f, err = toFloat(src, options)
+		v = floatScalar(f)
+	}
+	if err != nil {
+		if ei, ok := err.(ErrInvalid); ok {
+			err = ei.Cause
+		}
+		invalid(err)
+	}
+	if err := v.set(rv); err != nil {
+		invalid(err)
+	}
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8,
+		reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uintptr,
+		reflect.Float64, reflect.Float32:
+		return true
+	}
+	return false
+}
+
+type fieldPlan struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// structPlans caches field plans by struct type (reflect.Type → []fieldPlan).
+var structPlans sync.Map
+
+func planFor(rt reflect.Type) []fieldPlan {
+	if plan, ok := structPlans.Load(rt); ok {
+		return plan.([]fieldPlan)
+	}
+	plan := buildPlan(rt, nil, make(map[reflect.Type]bool))
+	actual, _ := structPlans.LoadOrStore(rt, plan)
+	return actual.([]fieldPlan)
+}
+
+// planField is a candidate for a struct plan, before conflicting names are resolved.
+type planField struct {
+	fieldPlan
+	depth  int
+	tagged bool
+}
+
+// buildPlan lists the fields of rt, including those promoted from embedded structs,
+// resolving conflicting names the same way as encoding/json:
+// the shallowest field wins, then a tagged field wins a tie, and otherwise the name is dropped.
+func buildPlan(rt reflect.Type, index []int, visited map[reflect.Type]bool) []fieldPlan {
+	candidates := collectFields(rt, index, 0, visited)
+	byName := make(map[string][]planField, len(candidates))
+	for _, field := range candidates {
+		byName[field.name] = append(byName[field.name], field)
+	}
+	plan := make([]fieldPlan, 0, len(candidates))
+	for _, field := range candidates {
+		if winner, ok := dominantField(byName[field.name]); ok && slices.Equal(winner.index, field.index) {
+			plan = append(plan, field.fieldPlan)
+		}
+	}
+	return plan
+}
+
+// collectFields lists every candidate field of rt: its own fields first, then those of embedded structs.
+func collectFields(rt reflect.Type, index []int, depth int, visited map[reflect.Type]bool) []planField {
+	visited[rt] = true
+	defer delete(visited, rt)
+	var fields, embedded []planField
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		name, omitEmpty, skip := parseTag(f)
+		if skip {
+			continue
+		}
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		ft := f.Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			if f.Type.Kind() == reflect.Pointer && !f.IsExported() {
+				// can't allocate unexported embedded pointers
+				continue
+			}
+			if !visited[ft] {
+				embedded = append(embedded, collectFields(ft, fieldIndex, depth+1, visited)...)
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		tagged := name != ""
+		if !tagged {
+			name = f.Name
+		}
+		fields = append(fields, planField{
+			fieldPlan: fieldPlan{name: name, index: fieldIndex, omitEmpty: omitEmpty},
+			depth:     depth,
+			tagged:    tagged,
+		})
+	}
+	return append(fields, embedded...)
+}
+
+// dominantField returns the field that wins among fields with the same name, reporting false if it is ambiguous.
+func dominantField(fields []planField) (planField, bool) {
+	shallowest := fields[0].depth
+	for _, field := range fields[1:] {
+		shallowest = min(shallowest, field.depth)
+	}
+	var winner planField
+	count, tagged := 0, 0
+	for _, field := range fields {
+		if field.depth != shallowest {
+			continue
+		}
+		count++
+		if field.tagged {
+			tagged++
+			winner = field
+		} else if tagged == 0 {
+			winner = field
+		}
+	}
+	switch {
+	case count == 1:
+		return winner, true
+	case tagged == 1:
+		return winner, true
+	}
+	return planField{}, false
+}
+
+func parseTag(f reflect.StructField) (name string, omitEmpty bool, skip bool) {
+	tag, ok := f.Tag.Lookup("into")
+	if !ok {
+		tag = f.Tag.Get("json")
+	}
+	if tag == "-" {
+		return "", false, true
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	for opts != "" {
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, false
+}
+
+func withoutFallback(options []Option) []Option {
+	for i, opt := range options {
+		if _, ok := opt.(fallbackValue); ok {
+			out := append([]Option(nil), options[:i]...)
+			for _, opt := range options[i+1:] {
+				if _, ok := opt.(fallbackValue); !ok {
+					out = append(out, opt)
+				}
+			}
+			return out
+		}
+	}
+	return options
+}
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// scanner is the same as sql.Scanner, without importing database/sql.
+type scanner interface {
+	Scan(src any) error
+}
+
+var (
+	scannerType  = reflect.TypeOf((*scanner)(nil)).Elem()
+	durationType = reflect.TypeOf(time.Duration(0))
+)
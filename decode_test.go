@@ -0,0 +1,231 @@
+package into_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guregu/into"
+)
+
+type decodeBase struct {
+	ID      int    `into:"id"`
+	Created string `json:"created"`
+}
+
+type decodeItem struct {
+	Name  string  `into:"name"`
+	Price float64 `into:"price"`
+}
+
+type decodeOrder struct {
+	decodeBase
+	Status  status            `into:"status"`
+	Items   []decodeItem      `into:"items"`
+	Tags    map[string]string `into:"tags,omitempty"`
+	Note    *string           `into:"note"`
+	Addr    netip.Addr        `into:"addr"`
+	At      time.Time         `into:"at"`
+	Count   uint8             `into:"count"`
+	Active  bool              `into:"active"`
+	Extra   any               `into:"extra"`
+	Ignored string            `into:"-"`
+	Default string
+	hidden  string
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+	src := map[string]any{
+		"id":      float64(7),
+		"created": "yesterday",
+		"status":  statusActive,
+		"items": []any{
+			map[string]any{"name": "cat", "price": 9.5},
+			map[any]any{"name": myString("dog"), "price": float32(1)},
+		},
+		"tags":    map[string]any{"a": "b"},
+		"note":    "hello",
+		"addr":    "192.0.2.1",
+		"at":      "2024-01-02T03:04:05Z",
+		"count":   int64(200),
+		"active":  true,
+		"extra":   []any{1, "two"},
+		"Ignored": "x",
+		"-":       "x",
+		"Default": "default",
+		"hidden":  "x",
+	}
+	var got decodeOrder
+	if err := into.Decode(src, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := decodeOrder{
+		decodeBase: decodeBase{ID: 7, Created: "yesterday"},
+		Status:     statusActive,
+		Items:      []decodeItem{{Name: "cat", Price: 9.5}, {Name: "dog", Price: 1}},
+		Tags:       map[string]string{"a": "b"},
+		Note:       into.Ptr("hello"),
+		Addr:       netip.MustParseAddr("192.0.2.1"),
+		At:         time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Count:      200,
+		Active:     true,
+		Extra:      []any{1, "two"},
+		Default:    "default",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bad result.\nwant: %#v\n got: %#v", want, got)
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	t.Parallel()
+	var src any
+	if err := json.Unmarshal([]byte(`{"id": 1, "items": [{"name": "a", "price": 2}]}`), &src); err != nil {
+		t.Fatal(err)
+	}
+	var got decodeOrder
+	if err := into.Decode(src, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != 1 || len(got.Items) != 1 || got.Items[0].Price != 2 {
+		t.Error("bad result:", got)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	t.Parallel()
+	src := map[string]any{
+		"id":    1.5,
+		"count": 300,
+		"items": []any{map[string]any{"name": 1, "price": "free"}},
+	}
+	var got decodeOrder
+	err := into.Decode(src, &got)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	for _, path := range []string{"id", "count", "items[0].name", "items[0].price"} {
+		if !strings.Contains(err.Error(), path+":") {
+			t.Error("error should mention", path, "but got:", err)
+		}
+	}
+	if !errors.Is(err, into.ErrRange) {
+		t.Error("error should report into.ErrRange:", err)
+	}
+}
+
+func TestDecodeOptions(t *testing.T) {
+	t.Parallel()
+	var got decodeItem
+	err := into.Decode(map[string]any{"NAME": "a", "Price": "1.5"}, &got, into.WithCaseInsensitive(), into.WithConvertStrings())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != (decodeItem{Name: "a", Price: 1.5}) {
+		t.Error("bad result:", got)
+	}
+}
+
+func TestDecodeDestination(t *testing.T) {
+	t.Parallel()
+	var got decodeItem
+	if err := into.Decode(map[string]any{}, got); err == nil {
+		t.Error("expected error for non-pointer destination")
+	}
+	var nums []int
+	if err := into.Decode([]any{1, 2}, &nums); err != nil || !reflect.DeepEqual(nums, []int{1, 2}) {
+		t.Error("bad result:", nums, err)
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	src := map[string]any{"name": "cat", "price": 9.5}
+	for i := 0; i < b.N; i++ {
+		var got decodeItem
+		if err := into.Decode(src, &got); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+type embedLeft struct {
+	X int
+	Y int `into:"Y"`
+	Z int
+}
+
+type embedRight struct {
+	X int
+	Y int
+}
+
+type embedDeep struct {
+	embedRight
+}
+
+type embedConflict struct {
+	embedLeft
+	embedRight
+}
+
+type embedShadow struct {
+	embedLeft
+	embedDeep
+	Z int
+}
+
+func TestDecodeEmbeddedConflicts(t *testing.T) {
+	t.Parallel()
+	src := map[string]any{"X": 1, "Y": 2, "Z": 4}
+
+	var conflict embedConflict
+	if err := into.Decode(src, &conflict); err != nil {
+		t.Fatal(err)
+	}
+	// X is ambiguous and dropped, and the tagged Y wins
+	want := embedConflict{embedLeft: embedLeft{Y: 2, Z: 4}}
+	if conflict != want {
+		t.Errorf("bad result.\nwant: %#v\n got: %#v", want, conflict)
+	}
+	got, err := into.Encode(embedConflict{embedLeft{1, 2, 4}, embedRight{5, 6}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]any{"Y": 2, "Z": 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("bad encoding. want: %v got: %v", want, got)
+	}
+
+	var shadow embedShadow
+	if err := into.Decode(src, &shadow); err != nil {
+		t.Fatal(err)
+	}
+	// the shallowest X and Z win
+	wantShadow := embedShadow{embedLeft: embedLeft{X: 1, Y: 2}, Z: 4}
+	if shadow != wantShadow {
+		t.Errorf("bad result.\nwant: %#v\n got: %#v", wantShadow, shadow)
+	}
+}
+
+func TestDecodeBool(t *testing.T) {
+	t.Parallel()
+	var dst struct {
+		A bool `into:"a"`
+		B bool `into:"b"`
+		C bool `into:"c"`
+	}
+	err := into.Decode(map[string]any{"a": myBool(true), "b": into.Ptr(true), "c": "true"}, &dst, into.WithConvertStrings())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dst.A || !dst.B || !dst.C {
+		t.Error("bad result:", dst)
+	}
+	if err := into.Decode(map[string]any{"a": "yes"}, &dst, into.WithConvertStrings()); into.ErrorPath(err) != "a" {
+		t.Error("want error at a, got:", err)
+	}
+}
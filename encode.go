@@ -0,0 +1,249 @@
+package into
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Encode flattens the struct v (or a pointer to one) into a map, the reverse of [Decode].
+// Field names follow the same rules as [Decode], and fields tagged with omitempty are skipped if empty.
+// Nested structs become nested maps, and slices and maps are copied with their elements encoded likewise.
+// Types implementing [encoding.TextMarshaler] (such as time.Time) are kept as-is.
+//
+// Given [WithStringValues], every value is converted to a string: plain numbers and bools are formatted with [strconv],
+// and everything else, including numbers that implement [encoding.TextMarshaler] or [fmt.Stringer]
+// such as time.Duration, is converted with [String].
+//
+// Encode does not stop at the first error: it returns every field error as [Errors],
+// each with its Path set to the field's location.
+// Values that refer back to themselves, such as a linked list whose last node points to the first,
+// are reported as errors at the point where the cycle repeats.
+func Encode(v any, options ...Option) (map[string]any, error) {
+	e := encoder{options: withoutFallback(options), stringify: should(options, stringValues)}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		e.enter(rv)
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, ErrInvalid{Value: v, Type: "struct"}
+	}
+	m := e.structure(rv, "")
+	return m, e.errs.err()
+}
+
+type encoder struct {
+	options   []Option
+	stringify bool
+	errs      Errors
+	// seen holds the pointers, slices, and maps being encoded on the current path, to detect cycles.
+	seen map[seenKey]struct{}
+}
+
+type seenKey struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+// errCycle is the cause of the error reported for values that refer back to themselves.
+var errCycle = errors.New("into: encountered a cycle")
+
+// enter records that the pointer, slice, or map rv is being encoded,
+// reporting false (and recording an error) if it already is, which means rv refers back to itself.
+// Call leave once rv is encoded.
+func (e *encoder) enter(rv reflect.Value) bool {
+	key := seenKey{typ: rv.Type(), ptr: rv.Pointer()}
+	if rv.Kind() == reflect.Slice {
+		key.len = rv.Len()
+	}
+	if _, ok := e.seen[key]; ok {
+		return false
+	}
+	if e.seen == nil {
+		e.seen = make(map[seenKey]struct{})
+	}
+	e.seen[key] = struct{}{}
+	return true
+}
+
+func (e *encoder) leave(rv reflect.Value) {
+	key := seenKey{typ: rv.Type(), ptr: rv.Pointer()}
+	if rv.Kind() == reflect.Slice {
+		key.len = rv.Len()
+	}
+	delete(e.seen, key)
+}
+
+// cycle records an error for rv, which refers back to itself.
+// The value is left out, as formatting it would never finish.
+func (e *encoder) cycle(rv reflect.Value, path string) {
+	e.fail(path, ErrInvalid{Type: rv.Type().String(), Cause: errCycle}, nil)
+}
+
+// fail records err at the given path.
+func (e *encoder) fail(path string, err error, value any) {
+	if isPanic(err) {
+		// a bug, not a coercion failure
+		panic(err)
+	}
+	invalid, ok := err.(ErrInvalid)
+	if !ok {
+		invalid = ErrInvalid{Value: value, Type: "string", Cause: err}
+	}
+	invalid.Path = joinPaths(path, invalid.Path)
+	e.errs = append(e.errs, invalid)
+}
+
+func (e *encoder) structure(rv reflect.Value, path string) map[string]any {
+	plan := planFor(rv.Type())
+	m := make(map[string]any, len(plan))
+	for _, field := range plan {
+		fv, ok := fieldByIndexNoAlloc(rv, field.index)
+		if !ok {
+			continue
+		}
+		if field.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		m[field.name] = e.value(fv, joinPaths(path, pathKey(field.name)))
+	}
+	return m
+}
+
+func (e *encoder) value(rv reflect.Value, path string) any {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		if rv.Kind() == reflect.Pointer && rv.Type().Implements(textMarshalerType) {
+			break
+		}
+		if rv.Kind() == reflect.Pointer {
+			if !e.enter(rv) {
+				e.cycle(rv, path)
+				return nil
+			}
+			defer e.leave(rv)
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+
+	if rv.Type().Implements(textMarshalerType) || reflect.PointerTo(rv.Type()).Implements(textMarshalerType) {
+		return e.leaf(rv, path)
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		return e.structure(rv, path)
+	case reflect.Slice:
+		if rv.IsNil() {
+			return nil
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return e.leaf(rv, path)
+		}
+		if !e.enter(rv) {
+			e.cycle(rv, path)
+			return nil
+		}
+		defer e.leave(rv)
+		fallthrough
+	case reflect.Array:
+		out := make([]any, rv.Len())
+		for i := range out {
+			out[i] = e.value(rv.Index(i), joinPaths(path, pathKey(i)))
+		}
+		return out
+	case reflect.Map:
+		if rv.IsNil() {
+			return nil
+		}
+		if !e.enter(rv) {
+			e.cycle(rv, path)
+			return nil
+		}
+		defer e.leave(rv)
+		out := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			k := fmt.Sprint(iter.Key().Interface())
+			out[k] = e.value(iter.Value(), joinPaths(path, pathKey(k)))
+		}
+		return out
+	}
+	return e.leaf(rv, path)
+}
+
+func (e *encoder) leaf(rv reflect.Value, path string) any {
+	if !rv.CanInterface() {
+		return nil
+	}
+	v := rv.Interface()
+	if !e.stringify {
+		return v
+	}
+	if rv.Kind() != reflect.Pointer && reflect.PointerTo(rv.Type()).Implements(textMarshalerType) && rv.CanAddr() {
+		v = rv.Addr().Interface()
+	}
+	switch v.(type) {
+	case encoding.TextMarshaler, fmt.Stringer:
+		// types such as time.Duration format themselves, even if they are numbers
+	default:
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
+			return strconv.FormatInt(rv.Int(), 10)
+		case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uintptr:
+			return strconv.FormatUint(rv.Uint(), 10)
+		case reflect.Float64:
+			return strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+		case reflect.Float32:
+			return strconv.FormatFloat(rv.Float(), 'f', -1, 32)
+		case reflect.Bool:
+			return strconv.FormatBool(rv.Bool())
+		}
+	}
+	str, err := Maybe(String, v, e.options...)
+	if err != nil {
+		e.fail(path, err, v)
+		return nil
+	}
+	return str
+}
+
+// fieldByIndexNoAlloc is like [reflect.Value.FieldByIndex], but reports false for fields of nil embedded struct pointers.
+func fieldByIndexNoAlloc(rv reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return reflect.Value{}, false
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(x)
+	}
+	return rv, true
+}
+
+// isEmptyValue reports whether rv is empty according to encoding/json's omitempty rules.
+func isEmptyValue(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return rv.IsZero()
+	}
+	return false
+}
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
@@ -0,0 +1,166 @@
+package into_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guregu/into"
+)
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	order := decodeOrder{
+		decodeBase: decodeBase{ID: 7, Created: "yesterday"},
+		Status:     statusActive,
+		Items:      []decodeItem{{Name: "cat", Price: 9.5}},
+		Note:       into.Ptr("hello"),
+		At:         at,
+		Count:      200,
+		Ignored:    "x",
+		hidden:     "x",
+	}
+	got, err := into.Encode(&order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"id":      7,
+		"created": "yesterday",
+		"status":  statusActive,
+		"items":   []any{map[string]any{"name": "cat", "price": 9.5}},
+		"note":    "hello",
+		"addr":    order.Addr,
+		"at":      at,
+		"count":   uint8(200),
+		"active":  false,
+		"extra":   nil,
+		"Default": "",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bad result.\nwant: %#v\n got: %#v", want, got)
+	}
+
+	var back decodeOrder
+	if err := into.Decode(got, &back); err != nil {
+		t.Fatal(err)
+	}
+	order.Ignored, order.hidden = "", ""
+	if !reflect.DeepEqual(back, order) {
+		t.Errorf("round trip failed.\nwant: %#v\n got: %#v", order, back)
+	}
+}
+
+func TestEncodeStrings(t *testing.T) {
+	t.Parallel()
+	got, err := into.Encode(struct {
+		N    int       `into:"n"`
+		F    float32   `into:"f"`
+		B    bool      `into:"b"`
+		S    myString  `into:"s"`
+		T    time.Time `into:"t"`
+		List []uint    `into:"list"`
+		Nil  *int      `into:"nil"`
+		Zero int       `into:"zero,omitempty"`
+	}{N: -1, F: 1.5, B: true, S: "x", T: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), List: []uint{1, 2}}, into.WithStringValues())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"n":    "-1",
+		"f":    "1.5",
+		"b":    "true",
+		"s":    "x",
+		"t":    "2024-01-02T00:00:00Z",
+		"list": []any{"1", "2"},
+		"nil":  nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bad result.\nwant: %#v\n got: %#v", want, got)
+	}
+}
+
+func TestEncodeStringsRoundTrip(t *testing.T) {
+	t.Parallel()
+	type timeout struct {
+		Wait  time.Duration `into:"wait"`
+		Count int           `into:"count"`
+	}
+	in := timeout{Wait: time.Second, Count: 3}
+	enc, err := into.Encode(in, into.WithStringValues())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]any{"wait": "1s", "count": "3"}; !reflect.DeepEqual(enc, want) {
+		t.Errorf("bad encoding.\nwant: %#v\n got: %#v", want, enc)
+	}
+	var out timeout
+	if err := into.Decode(enc, &out, into.WithConvertStrings()); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("bad round trip. want: %+v got: %+v", in, out)
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	t.Parallel()
+	if _, err := into.Encode(42); err == nil {
+		t.Error("expected error for non-struct")
+	}
+	_, err := into.Encode(struct {
+		M myMarshaler `into:"m"`
+	}{M: myMarshaler{err: myError}}, into.WithStringValues())
+	if err == nil || !strings.Contains(err.Error(), "m:") {
+		t.Error("expected error mentioning field, got:", err)
+	}
+}
+
+type encodeNode struct {
+	Name string         `into:"name"`
+	Next *encodeNode    `into:"next,omitempty"`
+	Kids []any          `into:"kids,omitempty"`
+	Meta map[string]any `into:"meta,omitempty"`
+}
+
+func TestEncodeCycle(t *testing.T) {
+	t.Parallel()
+	t.Run("pointer", func(t *testing.T) {
+		a := &encodeNode{Name: "a"}
+		a.Next = &encodeNode{Name: "b", Next: a}
+		_, err := into.Encode(a)
+		if into.ErrorPath(err) != "next.next" {
+			t.Error("want error at next.next, got:", err)
+		}
+	})
+	t.Run("slice", func(t *testing.T) {
+		kids := []any{nil}
+		kids[0] = kids
+		_, err := into.Encode(encodeNode{Kids: kids})
+		if into.ErrorPath(err) != "kids[0]" {
+			t.Error("want error at kids[0], got:", err)
+		}
+	})
+	t.Run("map", func(t *testing.T) {
+		meta := map[string]any{}
+		meta["self"] = meta
+		_, err := into.Encode(encodeNode{Meta: meta})
+		if into.ErrorPath(err) != "meta.self" {
+			t.Error("want error at meta.self, got:", err)
+		}
+	})
+	t.Run("shared", func(t *testing.T) {
+		shared := &encodeNode{Name: "shared"}
+		got, err := into.Encode(encodeNode{Kids: []any{shared, shared}})
+		if err != nil {
+			t.Fatal("unexpected error:", err)
+		}
+		want := map[string]any{"name": "shared"}
+		kids := got["kids"].([]any)
+		if !reflect.DeepEqual(kids[0], want) || !reflect.DeepEqual(kids[1], want) {
+			t.Error("bad result:", got)
+		}
+	})
+}
@@ -0,0 +1,177 @@
+package into
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CanEnum returns true if the given value can be coerced to a member of the given enum.
+// [Enum] will succeed without panicking if CanEnum returns true.
+//
+// See: [Enum] for supported types.
+func CanEnum[T ~int | ~string](x any, values map[string]T, options ...Option) bool {
+	_, err := enum(x, values, options)
+	return err == nil
+}
+
+// Enum coerces x into a member of an enum, given a table of member names to values.
+// Values are matched as follows:
+//   - T is matched against the values of the table
+//   - any string-like type supported by [String] is matched against the names of the table
+//     (case-insensitively given [WithCaseInsensitive]), then its values if T is a string type
+//   - for integer enums, any integer type supported by [Int] is matched against the values of the table,
+//     as are floats with no fractional part and strings given [WithConvertStrings]
+//   - nil
+//
+// Enum will panic with ErrInvalid listing the valid names if the value is not a member.
+func Enum[T ~int | ~string](x any, values map[string]T, options ...Option) T {
+	v, err := enum(x, values, options)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// EnumOK is like [Enum], but reports whether the conversion succeeded instead of panicking.
+func EnumOK[T ~int | ~string](x any, values map[string]T, options ...Option) (T, bool) {
+	v, err := enum(x, values, options)
+	return v, err == nil
+}
+
+// EnumErr is like [Enum], but returns an error instead of panicking.
+func EnumErr[T ~int | ~string](x any, values map[string]T, options ...Option) (T, error) {
+	return enum(x, values, options)
+}
+
+// EnumValue is like [Enum], but takes a reflect.Value such as a struct field.
+// Values with an underlying signed integer type are read directly, including unexported struct fields.
+func EnumValue[T ~int | ~string](rv reflect.Value, values map[string]T, options ...Option) T {
+	v, err := enumValue(rv, values, options)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func enumValue[T ~int | ~string](rv reflect.Value, values map[string]T, options []Option) (T, error) {
+	var zero T
+	typ := fmt.Sprintf("%T", zero)
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	if elem, ok := indirect(rv); ok {
+		if n, ok, err := intKind(elem); ok {
+			if err != nil {
+				x, _ := valueOf(elem)
+				return zero, ErrInvalid{Value: x, Type: typ, Cause: err}
+			}
+			return enum(n, values, options)
+		}
+	}
+	x, ok := valueOf(rv)
+	if !ok {
+		return zero, ErrInvalid{Type: typ}
+	}
+	return enum(x, values, options)
+}
+
+func enum[T ~int | ~string](x any, values map[string]T, options []Option) (T, error) {
+	var zero T
+	typ := fmt.Sprintf("%T", zero)
+	numeric := reflect.TypeOf(zero).Kind() == reflect.Int
+
+	switch v := x.(type) {
+	case T:
+		for _, member := range values {
+			if member == v {
+				return v, nil
+			}
+		}
+		return zero, enumError(x, typ, values)
+	case nil:
+		return fallbackFor[T](options, typ)
+	}
+	rv, ok := indirect(reflect.ValueOf(x))
+	if !ok {
+		// nil pointers, such as a nil *int or *T
+		return fallbackFor[T](options, typ)
+	}
+
+	if numeric {
+		n, err := toInt(x, nil)
+		if f, ok, _ := floatKind(rv); ok {
+			// floats such as those decoded by encoding/json, as long as they are integers
+			var i int64
+			i, err = floatScalar(f).int(strconv.IntSize)
+			if err != nil {
+				return zero, ErrInvalid{Value: x, Type: typ, Cause: err}
+			}
+			n = int(i)
+		}
+		if err == nil {
+			if v, ok := enumInt(n, values); ok {
+				return v, nil
+			}
+			return zero, enumError(x, typ, values)
+		}
+	}
+
+	if CanString(x, options...) {
+		str, err := stringFor(x, options)
+		if err != nil {
+			return zero, err
+		}
+		if str == "" {
+			return fallbackFor[T](options, typ)
+		}
+		if v, ok := values[str]; ok {
+			return v, nil
+		}
+		if should(options, foldCase) {
+			for name, v := range values {
+				if strings.EqualFold(name, str) {
+					return v, nil
+				}
+			}
+		}
+		if !numeric {
+			for _, v := range values {
+				if reflect.ValueOf(v).String() == str {
+					return v, nil
+				}
+			}
+		}
+		if numeric && should(options, convertStrings) {
+			if n, err := strconv.Atoi(str); err == nil {
+				if v, ok := enumInt(n, values); ok {
+					return v, nil
+				}
+			}
+		}
+		return zero, enumError(x, typ, values)
+	}
+
+	return zero, ErrInvalid{Value: x, Type: typ}
+}
+
+func enumInt[T ~int | ~string](n int, values map[string]T) (T, bool) {
+	for _, v := range values {
+		if reflect.ValueOf(v).Int() == int64(n) {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+func enumError[T ~int | ~string](x any, typ string, values map[string]T) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return ErrInvalid{Value: x, Type: typ, Cause: kindError{ErrRange, fmt.Errorf("must be one of: %s", strings.Join(names, ", "))}}
+}
@@ -0,0 +1,217 @@
+package into_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+type status int
+
+const (
+	statusPending status = iota + 1
+	statusActive
+	statusDeleted
+)
+
+var statusNames = map[string]status{
+	"pending": statusPending,
+	"active":  statusActive,
+	"deleted": statusDeleted,
+}
+
+type color string
+
+var colorNames = map[string]color{
+	"Red":  "red",
+	"Blue": "blue",
+}
+
+func TestEnum(t *testing.T) {
+	t.Parallel()
+	tests := table[status]{
+		{
+			name:  "name",
+			input: "active",
+			want:  statusActive,
+		},
+		{
+			name:  "enum value",
+			input: statusDeleted,
+			want:  statusDeleted,
+		},
+		{
+			name:  "int",
+			input: 2,
+			want:  statusActive,
+		},
+		{
+			name:  "int64",
+			input: int64(1),
+			want:  statusPending,
+		},
+		{
+			name:  "string subtype",
+			input: myString("pending"),
+			want:  statusPending,
+		},
+		{
+			name:  "case mismatch",
+			input: "ACTIVE",
+			err:   into.ErrInvalid{Value: "ACTIVE", Type: "into_test.status"},
+		},
+		{
+			name:  "case insensitive",
+			input: "ACTIVE",
+			want:  statusActive,
+			opts:  []into.Option{into.WithCaseInsensitive()},
+		},
+		{
+			name:  "numeric string",
+			input: "3",
+			want:  statusDeleted,
+			opts:  []into.Option{into.WithConvertStrings()},
+		},
+		{
+			name:  "numeric string without conversion",
+			input: "3",
+			err:   into.ErrInvalid{Value: "3", Type: "into_test.status"},
+		},
+		{
+			name:  "unknown int",
+			input: 42,
+			err:   into.ErrInvalid{Value: 42, Type: "into_test.status"},
+		},
+		{
+			name:  "unknown enum value",
+			input: status(42),
+			err:   into.ErrInvalid{Value: status(42), Type: "into_test.status"},
+		},
+		{
+			name:  "nil",
+			input: nil,
+			want:  0,
+		},
+		{
+			name:  "fallback",
+			input: nil,
+			want:  statusPending,
+			opts:  []into.Option{into.WithFallback(statusPending)},
+		},
+		{
+			name:  "nil *int",
+			input: (*int)(nil),
+			want:  0,
+		},
+		{
+			name:  "nil *int fallback",
+			input: (*int)(nil),
+			want:  statusPending,
+			opts:  []into.Option{into.WithFallback(statusPending)},
+		},
+		{
+			name:  "nil *string fallback",
+			input: (*string)(nil),
+			want:  statusPending,
+			opts:  []into.Option{into.WithFallback(statusPending)},
+		},
+		{
+			name:  "float",
+			input: 3.0,
+			want:  statusDeleted,
+		},
+		{
+			name:  "unknown float",
+			input: float32(42),
+			err:   into.ErrInvalid{Value: float32(42), Type: "into_test.status"},
+		},
+		{
+			name:  "fractional float",
+			input: 1.5,
+			err:   into.ErrInvalid{Value: 1.5, Type: "into_test.status", Cause: into.ErrLossy},
+		},
+		{
+			name:  "invalid type",
+			input: true,
+			err:   into.ErrInvalid{Value: true, Type: "into_test.status"},
+		},
+	}
+	tests.Run(t, func(x any, options ...into.Option) status {
+		return into.Enum(x, statusNames, options...)
+	})
+}
+
+func TestEnumJSON(t *testing.T) {
+	t.Parallel()
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(`{"status": 2, "fraction": 2.5}`), &doc); err != nil {
+		t.Fatal(err)
+	}
+	got, err := into.EnumErr(doc["status"], statusNames)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	if got != statusActive {
+		t.Error("bad result. want:", statusActive, "got:", got)
+	}
+	if _, err := into.EnumErr(doc["fraction"], statusNames); !errors.Is(err, into.ErrLossy) {
+		t.Error("expected ErrLossy, got:", err)
+	}
+}
+
+func TestEnumString(t *testing.T) {
+	t.Parallel()
+	tests := table[color]{
+		{
+			name:  "name",
+			input: "Red",
+			want:  "red",
+		},
+		{
+			name:  "value",
+			input: "blue",
+			want:  "blue",
+		},
+		{
+			name:  "enum value",
+			input: color("red"),
+			want:  "red",
+		},
+		{
+			name:  "unknown",
+			input: "green",
+			err:   into.ErrInvalid{Value: "green", Type: "into_test.color"},
+		},
+		{
+			name:  "int",
+			input: 1,
+			err:   into.ErrInvalid{Value: 1, Type: "into_test.color"},
+		},
+	}
+	tests.Run(t, func(x any, options ...into.Option) color {
+		return into.Enum(x, colorNames, options...)
+	})
+}
+
+func TestEnumError(t *testing.T) {
+	t.Parallel()
+	err := into.Try(func() {
+		into.Enum("archived", statusNames)
+	})
+	if err == nil || !strings.Contains(err.Error(), "active, deleted, pending") {
+		t.Error("expected error listing valid names, got:", err)
+	}
+}
+
+func TestCanEnum(t *testing.T) {
+	t.Parallel()
+	if !into.CanEnum("deleted", statusNames) {
+		t.Error("failed but should have succeeded")
+	}
+	if into.CanEnum("archived", statusNames) {
+		t.Error("succeeded but should have failed")
+	}
+}
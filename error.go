@@ -1,24 +1,164 @@
 package into
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 // ErrInvalid is an error returned when into could not convert a given value.
 type ErrInvalid struct {
 	Value any
 	Type  string
 
+	// Path is the location of Value inside of the input to a composite conversion
+	// such as [Slice], [Map], [Decode], or [GetInt], for example "items[3].price".
+	// It uses the syntax of [Get], and is empty for top-level values.
+	Path string
+
 	// Cause is non-nil if a fallible conversion returns an error (such as string conversion or TextMarshaler).
 	Cause error
 }
 
 func (err ErrInvalid) Error() string {
-	var extra string
+	var at, extra string
+	if err.Path != "" {
+		at = "at " + err.Path + ": "
+	}
 	if err.Cause != nil {
 		extra = "; " + err.Cause.Error()
 	}
-	return fmt.Sprintf("into: value %v of type %T is not a %s%s", err.Value, err.Value, err.Type, extra)
+	return fmt.Sprintf("into: %svalue %v of type %T is not a %s%s", at, err.Value, err.Value, err.Type, extra)
 }
 
 func (err ErrInvalid) Unwrap() error {
 	return err.Cause
 }
+
+// Is reports whether err is of the given kind: [ErrUnsupportedType], [ErrSyntax], [ErrRange], [ErrLossy], [ErrBadFallback], or [ErrRequired].
+// Errors from [strconv] are classified by their Err field.
+func (err ErrInvalid) Is(target error) bool {
+	switch target {
+	case ErrUnsupportedType:
+		return err.Cause == nil
+	case ErrSyntax:
+		return errors.Is(err.Cause, strconv.ErrSyntax)
+	case ErrRange:
+		return errors.Is(err.Cause, strconv.ErrRange)
+	}
+	return false
+}
+
+// Kinds of [ErrInvalid], for use with [errors.Is].
+var (
+	// ErrUnsupportedType means the value's type is not supported by the target.
+	ErrUnsupportedType = errors.New("into: unsupported type")
+	// ErrSyntax means the value is of a supported type, but is malformed, such as an unparseable string.
+	ErrSyntax = errors.New("into: invalid syntax")
+	// ErrRange means the value is well-formed, but outside of the range or set of values accepted by the target,
+	// such as an integer overflow or an unknown enum member.
+	ErrRange = errors.New("into: value out of range")
+	// ErrLossy means the value could only be converted by losing information, such as truncating 1.5 to an integer.
+	ErrLossy = errors.New("into: lossy conversion")
+	// ErrBadFallback means the value given to [WithFallback] is not of the target type.
+	ErrBadFallback = errors.New("into: invalid fallback value")
+	// ErrRequired means a value required by a [Schema] is missing or nil.
+	ErrRequired = errors.New("into: missing required value")
+)
+
+// kindError attaches a kind such as [ErrSyntax] to an error from elsewhere.
+type kindError struct {
+	kind error
+	err  error
+}
+
+func (err kindError) Error() string {
+	return err.err.Error()
+}
+
+func (err kindError) Unwrap() []error {
+	return []error{err.err, err.kind}
+}
+
+// ErrorPath returns the path of the first [ErrInvalid] found in err's tree, or "" if there is none.
+// See: [ErrInvalid.Path].
+func ErrorPath(err error) string {
+	var invalid ErrInvalid
+	if errors.As(err, &invalid) {
+		return invalid.Path
+	}
+	return ""
+}
+
+// atPath locates err at the given key (a string or other map key) or index (an int)
+// inside of its parent value. Errors other than ErrInvalid are wrapped in ErrInvalid,
+// using value and typ to describe the parent, except for recovered panics, which are returned as-is.
+func atPath(err error, key any, value any, typ string) error {
+	if isPanic(err) {
+		return err
+	}
+	invalid, ok := err.(ErrInvalid)
+	if !ok {
+		return ErrInvalid{Value: value, Type: typ, Path: pathKey(key), Cause: err}
+	}
+	invalid.Path = joinPaths(pathKey(key), invalid.Path)
+	return invalid
+}
+
+// pathKey formats a single path element: indexes as "[3]", and keys as-is or quoted in brackets if necessary.
+func pathKey(key any) string {
+	switch key := key.(type) {
+	case int:
+		return "[" + strconv.Itoa(key) + "]"
+	case string:
+		if key == "" || strings.ContainsAny(key, `.[]'"`) {
+			if strings.Contains(key, `"`) {
+				return `['` + key + `']`
+			}
+			return `["` + key + `"]`
+		}
+		return key
+	}
+	return pathKey(fmt.Sprint(key))
+}
+
+func joinPaths(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	case child[0] == '[':
+		return parent + child
+	}
+	return parent + "." + child
+}
+
+// Errors is a list of conversion errors, such as those returned by [Decode] or [Collector.Err].
+// It supports [errors.Is] and [errors.As] for each of its errors.
+type Errors []ErrInvalid
+
+func (errs Errors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (errs Errors) Unwrap() []error {
+	unwrapped := make([]error, len(errs))
+	for i, err := range errs {
+		unwrapped[i] = err
+	}
+	return unwrapped
+}
+
+// err returns errs as an error, or nil if empty.
+func (errs Errors) err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
@@ -2,6 +2,7 @@ package into_test
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -17,3 +18,115 @@ func TestErrInvalid(t *testing.T) {
 		t.Error("bad error message")
 	}
 }
+
+func TestErrInvalidPath(t *testing.T) {
+	t.Parallel()
+	err := into.ErrInvalid{Value: "x", Type: "float", Path: "items[3].price"}
+	want := "into: at items[3].price: value x of type string is not a float"
+	if err.Error() != want {
+		t.Error("bad error message. want:", want, "got:", err.Error())
+	}
+
+	wrapped := fmt.Errorf("wrapped: %w", err)
+	if got := into.ErrorPath(wrapped); got != "items[3].price" {
+		t.Error("bad path:", got)
+	}
+	if got := into.ErrorPath(myError); got != "" {
+		t.Error("bad path for unrelated error:", got)
+	}
+}
+
+func TestErrInvalidPathComposite(t *testing.T) {
+	t.Parallel()
+	root := map[string]any{
+		"items": []any{
+			map[string]any{"price": 1.5},
+			map[string]any{"price": "x", "a.b": "y"},
+		},
+	}
+
+	err := into.Try(func() {
+		into.GetFloat(root, "items[1].price")
+	})
+	if got := into.ErrorPath(err); got != "items[1].price" {
+		t.Error("bad GetFloat path:", got)
+	}
+
+	err = into.Try(func() {
+		into.GetInt(root, `items[1]["a.b"]`)
+	})
+	if got := into.ErrorPath(err); got != `items[1]["a.b"]` {
+		t.Error("bad GetInt path:", got)
+	}
+
+	var dst struct {
+		Items []struct {
+			Price float64 `into:"price"`
+		} `into:"items"`
+	}
+	err = into.Decode(root, &dst)
+	if got := into.ErrorPath(err); got != "items[1].price" {
+		t.Error("bad Decode path:", got)
+	}
+	if !strings.Contains(err.Error(), "into: at items[1].price: ") {
+		t.Error("bad Decode error message:", err)
+	}
+
+	_, err = into.Maybe(func(x any, options ...into.Option) map[string][]float64 {
+		return into.Map(x, into.String, func(x any, options ...into.Option) []float64 {
+			return into.Slice(x, func(x any, options ...into.Option) float64 {
+				return into.GetFloat(x, "price", options...)
+			}, options...)
+		}, options...)
+	}, root)
+	if got := into.ErrorPath(err); got != "items[1].price" {
+		t.Error("bad nested path:", got)
+	}
+}
+
+func TestErrorKinds(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		do   func()
+		kind error
+	}{
+		{name: "Int unsupported", do: func() { into.Int(1.5) }, kind: into.ErrUnsupportedType},
+		{name: "Int syntax", do: func() { into.Int("x", into.WithConvertStrings()) }, kind: into.ErrSyntax},
+		{name: "Int range", do: func() { into.Int("99999999999999999999", into.WithConvertStrings()) }, kind: into.ErrRange},
+		{name: "Uint syntax", do: func() { into.Uint("-1", into.WithConvertStrings()) }, kind: into.ErrSyntax},
+		{name: "Float syntax", do: func() { into.Float("x", into.WithConvertStrings()) }, kind: into.ErrSyntax},
+		{name: "Float range", do: func() { into.Float("1e999", into.WithConvertStrings()) }, kind: into.ErrRange},
+		{name: "String unsupported", do: func() { into.String(42) }, kind: into.ErrUnsupportedType},
+		{name: "Addr syntax", do: func() { into.Addr("x", into.WithConvertStrings()) }, kind: into.ErrSyntax},
+		{name: "URL syntax", do: func() { into.URL("http://[::1") }, kind: into.ErrSyntax},
+		{name: "URL scheme", do: func() { into.URL("ftp://x", into.WithSchemes("https")) }, kind: into.ErrRange},
+		{name: "Enum member", do: func() { into.Enum("x", statusNames) }, kind: into.ErrRange},
+		{name: "Slice element", do: func() { into.Slice([]any{"x"}, into.Int, into.WithConvertStrings()) }, kind: into.ErrSyntax},
+		{name: "Decode overflow", do: func() {
+			var dst struct{ N int8 }
+			if err := into.Decode(map[string]any{"N": 1000}, &dst); err != nil {
+				panic(err)
+			}
+		}, kind: into.ErrRange},
+		{name: "Decode lossy", do: func() {
+			var dst struct{ N int }
+			if err := into.Decode(map[string]any{"N": 1.5}, &dst); err != nil {
+				panic(err)
+			}
+		}, kind: into.ErrLossy},
+		{name: "bad fallback", do: func() { into.Int(nil, into.WithFallback("x")) }, kind: into.ErrBadFallback},
+	}
+	kinds := []error{into.ErrUnsupportedType, into.ErrSyntax, into.ErrRange, into.ErrLossy, into.ErrBadFallback}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := into.Try(test.do)
+			for _, kind := range kinds {
+				if got, want := errors.Is(err, kind), kind == test.kind; got != want {
+					t.Errorf("errors.Is(%v, %v) = %v, want %v", err, kind, got, want)
+				}
+			}
+		})
+	}
+}
@@ -89,68 +89,114 @@ func CanFloat(x any, options ...Option) bool {
 //
 // Float will panic with ErrInvalid if the value cannot be coerced.
 func Float(x any, options ...Option) float64 {
+	v, err := toFloat(x, options)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// FloatOK is like [Float], but reports whether the conversion succeeded instead of panicking.
+func FloatOK(x any, options ...Option) (float64, bool) {
+	v, err := toFloat(x, options)
+	return v, err == nil
+}
+
+// FloatErr is like [Float], but returns an error instead of panicking.
+func FloatErr(x any, options ...Option) (float64, error) {
+	return toFloat(x, options)
+}
+
+func toFloat(x any, options []Option) (float64, error) {
 	switch x := x.(type) {
 	case float64:
-		return x
+		return x, nil
 	case float32:
-		return float64(x)
+		return float64(x), nil
 	case *float64:
 		if x == nil {
 			goto fallback
 		}
-		return *x
+		return *x, nil
 	case *float32:
 		if x == nil {
 			goto fallback
 		}
-		return float64(*x)
+		return float64(*x), nil
 	case nil:
 		goto fallback
 	}
 
 	if !should(options, skipReflect) {
-		rv := reflect.ValueOf(x)
-		for rv.Kind() == reflect.Pointer {
-			if rv.IsNil() {
-				goto fallback
-			}
-			rv = rv.Elem()
+		n, ok, err := floatConverters.convert(x)
+		if err == errNil {
+			goto fallback
 		}
-		switch rv.Kind() {
-		case reflect.Float64, reflect.Float32:
-			return rv.Float()
+		if ok {
+			if err != nil {
+				return 0, ErrInvalid{Value: x, Type: "float", Cause: err}
+			}
+			return n, nil
 		}
 	}
 
 	if should(options, convertStrings) && CanString(x) {
-		var str string
-		if should(options, skipReflect) {
-			str = String(x, skipReflect)
-		} else {
-			str = String(x)
+		str, err := stringFor(x, options)
+		if err != nil {
+			return 0, err
 		}
 		if str == "" {
 			goto fallback
 		}
 		n, err := strconv.ParseFloat(str, 64)
 		if err != nil {
-			panic(ErrInvalid{Value: x, Type: "float", Cause: err})
+			return 0, ErrInvalid{Value: x, Type: "float", Cause: err}
 		}
-		return n
+		return n, nil
 	}
 
-	panic(ErrInvalid{Value: x, Type: "float"})
+	return 0, ErrInvalid{Value: x, Type: "float"}
 
 fallback:
-	var null float64
-	for _, opt := range options {
-		if opt, ok := opt.(fallbackValue); ok {
-			null, ok = opt.x.(float64)
-			if !ok {
-				panic(fmt.Errorf("invalid fallback value: %v (type of %T), must be %s", opt, opt, "float64"))
-			}
-			break
+	return fallbackFor[float64](options, "float64")
+}
+
+// FloatValue is like [Float], but takes a reflect.Value such as a struct field.
+// Values with an underlying float type are converted directly, without boxing them into an interface,
+// including unexported struct fields.
+func FloatValue(rv reflect.Value, options ...Option) float64 {
+	n, err := floatValue(rv, options)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func floatValue(rv reflect.Value, options []Option) (float64, error) {
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	rv, ok := indirect(rv)
+	if !ok {
+		return fallbackFor[float64](options, "float64")
+	}
+	if n, ok, err := floatKind(rv); ok {
+		if err != nil {
+			x, _ := valueOf(rv)
+			return 0, ErrInvalid{Value: x, Type: "float", Cause: err}
 		}
+		return n, nil
+	}
+	return fromValue(rv, "float", options, toFloat)
+}
+
+var floatConverters = newConverterCache(floatKind)
+
+// floatKind converts rv if it has an underlying float type, reporting false otherwise.
+func floatKind(rv reflect.Value) (float64, bool, error) {
+	switch rv.Kind() {
+	case reflect.Float64, reflect.Float32:
+		return rv.Float(), true, nil
 	}
-	return null
+	return 0, false, nil
 }
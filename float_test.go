@@ -118,6 +118,7 @@ func TestFloat(t *testing.T) {
 		},
 	}
 	tests.Run(t, into.Float)
+	tests.RunErr(t, into.FloatErr)
 }
 
 func TestCanFloat(t *testing.T) {
@@ -237,6 +238,28 @@ func BenchmarkFloatWithOptions(b *testing.B) {
 	}
 }
 
+func BenchmarkFloatNamed(b *testing.B) {
+	x := myFloat(42)
+	for i := 0; i < b.N; i++ {
+		want := 42.0
+		got := into.Float(x)
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}
+
+func BenchmarkFloatPointers(b *testing.B) {
+	x := into.Ptr(into.Ptr(myFloat(42)))
+	for i := 0; i < b.N; i++ {
+		want := 42.0
+		got := into.Float(x)
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}
+
 func BenchmarkFloatFallback(b *testing.B) {
 	fallback := into.WithFallback(42.5)
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,94 @@
+package into
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Group runs functions in separate goroutines, recovering their panics as errors the same way as [Try].
+// It is similar to golang.org/x/sync/errgroup, but for functions that panic instead of returning errors.
+//
+// The zero value is a valid Group that collects every error.
+// Use [GroupWithContext] to fail fast instead.
+type Group struct {
+	cancel   context.CancelCauseFunc
+	failFast bool
+
+	wg  sync.WaitGroup
+	sem chan struct{}
+
+	mu   sync.Mutex
+	errs []error
+}
+
+// GroupWithContext returns a new [Group] that fails fast, and a derived context.
+// The derived context is canceled, with the error as its cause, as soon as any function panics
+// or when [Group.Wait] returns, whichever occurs first.
+func GroupWithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group{cancel: cancel, failFast: true}, ctx
+}
+
+// SetLimit limits the number of functions running at once to n.
+// Calls to [Group.Go] block until they can start. A negative n removes the limit.
+// SetLimit must not be called while functions are running.
+func (g *Group) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	if len(g.sem) != 0 {
+		panic("into: SetLimit called while functions are running")
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go runs fn in a new goroutine, recovering it if it panics.
+func (g *Group) Go(fn func()) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.done()
+		if err := Try(fn); err != nil {
+			g.fail(err)
+		}
+	}()
+}
+
+func (g *Group) done() {
+	if g.sem != nil {
+		<-g.sem
+	}
+	g.wg.Done()
+}
+
+func (g *Group) fail(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.errs = append(g.errs, err)
+	if g.cancel != nil && len(g.errs) == 1 {
+		g.cancel(err)
+	}
+}
+
+// Wait blocks until every function started by [Group.Go] has returned.
+// For groups created by [GroupWithContext], it returns the first error.
+// Otherwise, it returns every error joined together, in the order they occurred.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(nil)
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(g.errs) == 0 {
+		return nil
+	}
+	if g.failFast {
+		return g.errs[0]
+	}
+	return errors.Join(g.errs...)
+}
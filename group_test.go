@@ -0,0 +1,109 @@
+package into_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/guregu/into"
+)
+
+func TestGroup(t *testing.T) {
+	t.Parallel()
+	rows := []any{"1", "2", "x", "4", "y"}
+	results := make([]int, len(rows))
+	var g into.Group
+	for i, row := range rows {
+		i, row := i, row
+		g.Go(func() {
+			results[i] = into.Int(row, into.WithConvertStrings())
+		})
+	}
+	err := g.Wait()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	var invalid into.ErrInvalid
+	if !errors.As(err, &invalid) {
+		t.Error("expected ErrInvalid, got:", err)
+	}
+	if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != 2 {
+		t.Error("expected 2 errors, got:", n)
+	}
+	if results[3] != 4 {
+		t.Error("successful conversions should complete:", results)
+	}
+}
+
+func TestGroupNoErrors(t *testing.T) {
+	t.Parallel()
+	var g into.Group
+	g.Go(func() {})
+	if err := g.Wait(); err != nil {
+		t.Error("unexpected error:", err)
+	}
+}
+
+func TestGroupWithContext(t *testing.T) {
+	t.Parallel()
+	g, ctx := into.GroupWithContext(context.Background())
+	g.Go(func() {
+		into.Int("x")
+	})
+	g.Go(func() {
+		select {
+		case <-ctx.Done():
+		case <-time.After(10 * time.Second):
+			panic("context not canceled")
+		}
+	})
+	err := g.Wait()
+	var invalid into.ErrInvalid
+	if !errors.As(err, &invalid) {
+		t.Error("expected ErrInvalid, got:", err)
+	}
+	if cause := context.Cause(ctx); cause != err {
+		t.Error("context cause should be the first error, got:", cause)
+	}
+}
+
+func TestGroupRuntimePanic(t *testing.T) {
+	t.Parallel()
+	var g into.Group
+	g.Go(func() {
+		var s []int
+		_ = s[1]
+	})
+	var p into.PanicError
+	if err := g.Wait(); !errors.As(err, &p) {
+		t.Error("expected PanicError, got:", err)
+	}
+}
+
+func TestGroupLimit(t *testing.T) {
+	t.Parallel()
+	var g into.Group
+	g.SetLimit(2)
+	var running, peak atomic.Int32
+	for i := 0; i < 10; i++ {
+		g.Go(func() {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if p := peak.Load(); p > 2 {
+		t.Error("limit exceeded:", p)
+	}
+}
@@ -0,0 +1,52 @@
+package into
+
+import (
+	"strconv"
+	"time"
+)
+
+// inferLayouts are the time layouts tried by [Infer], in order.
+var inferLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	time.DateTime,
+	time.DateOnly,
+}
+
+// Infer parses s into the most specific type it represents, such as a CSV cell or query parameter.
+// It tries the following, in order:
+//   - int, as parsed by [Int] given [WithConvertStrings]
+//   - uint, for integers too large for int
+//   - float64, as parsed by [Float] (including "NaN" and "Inf")
+//   - bool, as parsed by [Bool] (including "t" and "F")
+//   - time.Time, for RFC 3339 timestamps, and dates and times in the form "2006-01-02 15:04:05" or "2006-01-02"
+//   - otherwise, s itself
+//
+// An empty string produces the value given by [WithFallback], or the empty string.
+func Infer(s string, options ...Option) any {
+	if s == "" {
+		if v, err := fallbackFor[any](options, ""); err == nil && v != nil {
+			return v
+		}
+		return s
+	}
+	parse := []Option{convertStrings}
+	if n, err := toInt(s, parse); err == nil {
+		return n
+	}
+	if n, err := strconv.ParseUint(s, 10, strconv.IntSize); err == nil {
+		return uint(n)
+	}
+	if f, err := toFloat(s, parse); err == nil {
+		return f
+	}
+	if b, err := toBool(s, parse); err == nil {
+		return b
+	}
+	for _, layout := range inferLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return s
+}
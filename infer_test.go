@@ -0,0 +1,76 @@
+package into_test
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/guregu/into"
+)
+
+func TestInfer(t *testing.T) {
+	t.Parallel()
+	table := []struct {
+		input string
+		want  any
+	}{
+		{"42", 42},
+		{"-7", -7},
+		{strconv.FormatUint(math.MaxUint, 10), uint(math.MaxUint)},
+		{strconv.FormatUint(math.MaxUint, 10) + "0", float64(math.MaxUint) * 10},
+		{"4.2", 4.2},
+		{"1e3", 1000.0},
+		{"true", true},
+		{"FALSE", false},
+		{"2024-01-01", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2024-01-01 12:30:00", time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)},
+		{"2024-01-01T12:30:00Z", time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)},
+		{"hello", "hello"},
+		{" 42", " 42"},
+		{"", ""},
+	}
+	for _, test := range table {
+		got := into.Infer(test.input)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Infer(%q) = %v (%T), want %v (%T)", test.input, got, got, test.want, test.want)
+		}
+	}
+	if got := into.Infer("", into.WithFallback(0)); got != 0 {
+		t.Errorf("Infer with fallback = %v (%T), want 0", got, got)
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	t.Parallel()
+	table := []struct {
+		input any
+		want  into.Kind
+	}{
+		{nil, into.KindNil},
+		{(*int)(nil), into.KindNil},
+		{true, into.KindBool},
+		{myBool(true), into.KindBool},
+		{42, into.KindInt},
+		{int8(42), into.KindInt},
+		{into.Ptr(myInt(42)), into.KindInt},
+		{time.Second, into.KindInt},
+		{uint(42), into.KindUint},
+		{myUint(42), into.KindUint},
+		{4.2, into.KindFloat},
+		{into.Ptr(float32(4.2)), into.KindFloat},
+		{"hello", into.KindString},
+		{myString("hello"), into.KindString},
+		{[]byte("hello"), into.KindString},
+		{big.NewInt(42), into.KindString},
+		{struct{}{}, into.KindUnknown},
+		{map[string]any{}, into.KindUnknown},
+	}
+	for _, test := range table {
+		if got := into.KindOf(test.input); got != test.want {
+			t.Errorf("KindOf(%#v) = %v, want %v", test.input, got, test.want)
+		}
+	}
+}
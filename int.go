@@ -91,89 +91,145 @@ func CanInt(x any, options ...Option) bool {
 //
 // Int will panic with ErrInvalid if the value cannot be coerced.
 func Int(x any, options ...Option) int {
+	v, err := toInt(x, options)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// IntOK is like [Int], but reports whether the conversion succeeded instead of panicking.
+func IntOK(x any, options ...Option) (int, bool) {
+	v, err := toInt(x, options)
+	return v, err == nil
+}
+
+// IntErr is like [Int], but returns an error instead of panicking.
+func IntErr(x any, options ...Option) (int, error) {
+	return toInt(x, options)
+}
+
+func toInt(x any, options []Option) (int, error) {
 	switch x := x.(type) {
 	case int:
-		return x
+		return x, nil
 	case int64:
-		return int(x)
+		if int64(int(x)) != x {
+			return 0, ErrInvalid{Value: x, Type: "int", Cause: ErrRange}
+		}
+		return int(x), nil
 	case int32:
-		return int(x)
+		return int(x), nil
 	case int16:
-		return int(x)
+		return int(x), nil
 	case int8:
-		return int(x)
+		return int(x), nil
 	case *int:
 		if x == nil {
 			goto fallback
 		}
-		return *x
+		return *x, nil
 	case *int64:
 		if x == nil {
 			goto fallback
 		}
-		return int(*x)
+		if int64(int(*x)) != *x {
+			return 0, ErrInvalid{Value: x, Type: "int", Cause: ErrRange}
+		}
+		return int(*x), nil
 	case *int32:
 		if x == nil {
 			goto fallback
 		}
-		return int(*x)
+		return int(*x), nil
 	case *int16:
 		if x == nil {
 			goto fallback
 		}
-		return int(*x)
+		return int(*x), nil
 	case *int8:
 		if x == nil {
 			goto fallback
 		}
-		return int(*x)
+		return int(*x), nil
 	case nil:
 		goto fallback
 	}
 
 	if !should(options, skipReflect) {
-		rv := reflect.ValueOf(x)
-		for rv.Kind() == reflect.Pointer {
-			if rv.IsNil() {
-				goto fallback
-			}
-			rv = rv.Elem()
+		n, ok, err := intConverters.convert(x)
+		if err == errNil {
+			goto fallback
 		}
-		switch rv.Kind() {
-		case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
-			return int(rv.Int())
+		if ok {
+			if err != nil {
+				return 0, ErrInvalid{Value: x, Type: "int", Cause: err}
+			}
+			return n, nil
 		}
 	}
 
 	if should(options, convertStrings) && CanString(x) {
-		var str string
-		if should(options, skipReflect) {
-			str = String(x, skipReflect)
-		} else {
-			str = String(x)
+		str, err := stringFor(x, options)
+		if err != nil {
+			return 0, err
 		}
 		if str == "" {
 			goto fallback
 		}
 		n, err := strconv.Atoi(str)
 		if err != nil {
-			panic(ErrInvalid{Value: x, Type: "int", Cause: err})
+			return 0, ErrInvalid{Value: x, Type: "int", Cause: err}
 		}
-		return n
+		return n, nil
 	}
 
-	panic(ErrInvalid{Value: x, Type: "int"})
+	return 0, ErrInvalid{Value: x, Type: "int"}
 
 fallback:
-	var null int
-	for _, opt := range options {
-		if opt, ok := opt.(fallbackValue); ok {
-			null, ok = opt.x.(int)
-			if !ok {
-				panic(fmt.Errorf("invalid fallback value: %v (type of %T), must be %s", opt, opt, "int"))
-			}
-			break
+	return fallbackFor[int](options, "int")
+}
+
+// IntValue is like [Int], but takes a reflect.Value such as a struct field.
+// Values with an underlying signed integer type are converted directly, without boxing them into an interface,
+// including unexported struct fields.
+func IntValue(rv reflect.Value, options ...Option) int {
+	n, err := intValue(rv, options)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func intValue(rv reflect.Value, options []Option) (int, error) {
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	rv, ok := indirect(rv)
+	if !ok {
+		return fallbackFor[int](options, "int")
+	}
+	if n, ok, err := intKind(rv); ok {
+		if err != nil {
+			x, _ := valueOf(rv)
+			return 0, ErrInvalid{Value: x, Type: "int", Cause: err}
 		}
+		return n, nil
+	}
+	return fromValue(rv, "int", options, toInt)
+}
+
+var intConverters = newConverterCache(intKind)
+
+// intKind converts rv if it has an underlying signed integer type, reporting false otherwise.
+func intKind(rv reflect.Value) (int, bool, error) {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
+		n := rv.Int()
+		if int64(int(n)) != n {
+			return 0, true, ErrRange
+		}
+		return int(n), true, nil
 	}
-	return null
+	return 0, false, nil
 }
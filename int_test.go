@@ -1,6 +1,7 @@
 package into_test
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -163,6 +164,7 @@ func TestInt(t *testing.T) {
 		},
 	}
 	tests.Run(t, into.Int)
+	tests.RunErr(t, into.IntErr)
 }
 
 func TestCanInt(t *testing.T) {
@@ -270,6 +272,24 @@ func TestIntInvalidFallback(t *testing.T) {
 	}
 }
 
+func TestIntOK(t *testing.T) {
+	t.Parallel()
+	if got, ok := into.IntOK("42", into.WithConvertStrings()); !ok || got != 42 {
+		t.Error("bad result:", got, ok)
+	}
+	if got, ok := into.IntOK("cat", into.WithConvertStrings()); ok || got != 0 {
+		t.Error("unexpected success:", got, ok)
+	}
+	_, err := into.IntErr("cat", into.WithConvertStrings())
+	if !errors.Is(err, into.ErrSyntax) {
+		t.Error("unexpected error:", err)
+	}
+	_, err = into.IntErr(nil, into.WithFallback("bad"))
+	if !errors.Is(err, into.ErrBadFallback) {
+		t.Error("unexpected error:", err)
+	}
+}
+
 func BenchmarkInt(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		want := 42
@@ -290,6 +310,37 @@ func BenchmarkIntWithOptions(b *testing.B) {
 	}
 }
 
+func BenchmarkIntOK(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		got, ok := into.IntOK("42", into.WithConvertStrings())
+		if !ok || got != 42 {
+			b.Fatal("bad result:", got, ok)
+		}
+	}
+}
+
+func BenchmarkIntNamed(b *testing.B) {
+	x := myInt(42)
+	for i := 0; i < b.N; i++ {
+		want := 42
+		got := into.Int(x)
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}
+
+func BenchmarkIntPointers(b *testing.B) {
+	x := into.Ptr(into.Ptr(myInt(42)))
+	for i := 0; i < b.N; i++ {
+		want := 42
+		got := into.Int(x)
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}
+
 func BenchmarkIntFallback(b *testing.B) {
 	fallback := into.WithFallback(42)
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,239 @@
+package into
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// jsonSchemaDialect is the $schema of documents produced by [JSONSchema].
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema returns a JSON Schema (draft 2020-12) document describing s,
+// such as an [Object] or the result of [SchemaOf].
+//
+// Fields are translated as follows:
+//   - [IntField] and [UintField] are "integer" (with a minimum of 0 for UintField), [FloatField] is "number",
+//     [StringField] is "string", [BoolField] is "boolean", and [AnyField] has no type
+//   - [SliceOf] is "array" with "items", and objects have "properties" and "required"
+//   - Min and Max are "minimum" and "maximum", OneOf is "enum", and Pattern is "pattern"
+//   - MinLen and MaxLen are "minLength" and "maxLength" for strings, or "minItems" and "maxItems" for arrays
+func JSONSchema(s Schema) ([]byte, error) {
+	doc := jsonSchemaFor(s)
+	doc["$schema"] = jsonSchemaDialect
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+func jsonSchemaFor(s Schema) map[string]any {
+	var f Field
+	switch s := s.(type) {
+	case Field:
+		f = s
+	case Object:
+		f = ObjectField(s)
+	default:
+		return map[string]any{}
+	}
+
+	doc := make(map[string]any)
+	switch f.typ {
+	case fieldInt:
+		doc["type"] = "integer"
+	case fieldUint:
+		doc["type"] = "integer"
+		doc["minimum"] = 0
+	case fieldFloat:
+		doc["type"] = "number"
+	case fieldString:
+		doc["type"] = "string"
+	case fieldBool:
+		doc["type"] = "boolean"
+	case fieldNull:
+		doc["type"] = "null"
+	case fieldSlice:
+		doc["type"] = "array"
+		if f.elem != nil {
+			doc["items"] = jsonSchemaFor(f.elem)
+		}
+	case fieldObject:
+		doc["type"] = "object"
+		props := make(map[string]any, len(f.fields))
+		var required []string
+		for key, schema := range f.fields {
+			props[key] = jsonSchemaFor(schema)
+			if field, ok := schema.(Field); ok && field.required {
+				required = append(required, key)
+			}
+		}
+		doc["properties"] = props
+		if required != nil {
+			sort.Strings(required)
+			doc["required"] = required
+		}
+	}
+
+	if typ, ok := doc["type"].(string); ok && f.nullable && f.typ != fieldNull {
+		doc["type"] = []string{typ, "null"}
+	}
+	if f.min != nil {
+		doc["minimum"] = *f.min
+	}
+	if f.max != nil {
+		doc["maximum"] = *f.max
+	}
+	minLen, maxLen := "minLength", "maxLength"
+	if f.typ == fieldSlice {
+		minLen, maxLen = "minItems", "maxItems"
+	}
+	if f.minLen != nil {
+		doc[minLen] = *f.minLen
+	}
+	if f.maxLen != nil {
+		doc[maxLen] = *f.maxLen
+	}
+	if f.enum != nil {
+		doc["enum"] = f.enum
+	}
+	if f.pattern != nil {
+		doc["pattern"] = f.pattern.String()
+	}
+	return doc
+}
+
+// jsonSchemaDoc is the subset of JSON Schema understood by [ParseJSONSchema].
+type jsonSchemaDoc struct {
+	Type       json.RawMessage           `json:"type"`
+	Properties map[string]*jsonSchemaDoc `json:"properties"`
+	Required   []string                  `json:"required"`
+	Items      *jsonSchemaDoc            `json:"items"`
+	Enum       []any                     `json:"enum"`
+	Minimum    *float64                  `json:"minimum"`
+	Maximum    *float64                  `json:"maximum"`
+	MinLength  *int                      `json:"minLength"`
+	MaxLength  *int                      `json:"maxLength"`
+	MinItems   *int                      `json:"minItems"`
+	MaxItems   *int                      `json:"maxItems"`
+	Pattern    *string                   `json:"pattern"`
+}
+
+// ParseJSONSchema builds a schema from a JSON Schema document, for use with [Field.Validate] and [Field.Coerce].
+// It supports the keywords produced by [JSONSchema]: type, properties, required, items, enum,
+// minimum and maximum, minLength and maxLength, minItems and maxItems, and pattern.
+// Other keywords are ignored.
+//
+// Properties with a type reject nil even if they are not required,
+// unless the type is a list of one type and "null".
+// Patterns use Go's [regexp] syntax,
+// which covers most JSON Schema patterns.
+func ParseJSONSchema(data []byte) (Field, error) {
+	var doc jsonSchemaDoc
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return Field{}, fmt.Errorf("into: invalid JSON Schema: %w", err)
+	}
+	return doc.field("")
+}
+
+func (doc *jsonSchemaDoc) field(path string) (Field, error) {
+	fail := func(format string, args ...any) (Field, error) {
+		at := ""
+		if path != "" {
+			at = " at " + path
+		}
+		return Field{}, fmt.Errorf("into: invalid JSON Schema%s: "+format, append([]any{at}, args...)...)
+	}
+
+	typ, nullable, err := doc.typeName()
+	if err != nil {
+		return fail("%w", err)
+	}
+	var f Field
+	switch typ {
+	case "":
+		f = AnyField()
+	case "null":
+		f = nullField()
+	case "integer":
+		f = IntField()
+	case "number":
+		f = FloatField()
+	case "string":
+		f = StringField()
+	case "boolean":
+		f = BoolField()
+	case "array":
+		f = SliceOf(nil)
+		if doc.Items != nil {
+			elem, err := doc.Items.field(joinPaths(path, "items"))
+			if err != nil {
+				return Field{}, err
+			}
+			f.elem = elem
+		}
+	case "object":
+		o := make(Object, len(doc.Properties))
+		for key, prop := range doc.Properties {
+			field, err := prop.field(joinPaths(path, joinPaths("properties", pathKey(key))))
+			if err != nil {
+				return Field{}, err
+			}
+			o[key] = field
+		}
+		for _, key := range doc.Required {
+			field, ok := o[key].(Field)
+			if !ok {
+				field = AnyField()
+			}
+			o[key] = field.Required()
+		}
+		f = ObjectField(o)
+	default:
+		return fail("unsupported type %q", typ)
+	}
+
+	f.nullable = f.nullable || nullable
+	f.nonNull = typ != ""
+	f.min, f.max = doc.Minimum, doc.Maximum
+	f.minLen, f.maxLen = doc.MinLength, doc.MaxLength
+	if typ == "array" {
+		f.minLen, f.maxLen = doc.MinItems, doc.MaxItems
+	}
+	f.enum = doc.Enum
+	if doc.Pattern != nil {
+		re, err := regexp.Compile(*doc.Pattern)
+		if err != nil {
+			return fail("%w", err)
+		}
+		f.pattern = re
+	}
+	return f, nil
+}
+
+// typeName returns the type keyword, which may be a string or a list of one type and "null",
+// reporting whether the list form allows null.
+func (doc *jsonSchemaDoc) typeName() (typ string, nullable bool, err error) {
+	if len(doc.Type) == 0 {
+		return "", false, nil
+	}
+	if err := json.Unmarshal(doc.Type, &typ); err == nil {
+		return typ, false, nil
+	}
+	var types []string
+	if err := json.Unmarshal(doc.Type, &types); err != nil {
+		return "", false, fmt.Errorf("type must be a string or list of strings: %s", doc.Type)
+	}
+	for _, t := range types {
+		if t == "null" {
+			nullable = true
+			continue
+		}
+		if typ != "" {
+			return "", false, fmt.Errorf("unsupported type list %s", doc.Type)
+		}
+		typ = t
+	}
+	if typ == "" {
+		typ = "null"
+	}
+	return typ, nullable, nil
+}
@@ -0,0 +1,260 @@
+package into_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/guregu/into"
+)
+
+func jsonEqual(t *testing.T, got []byte, want string) {
+	t.Helper()
+	var g, w any
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatal("bad JSON:", err, string(got))
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatal("bad test JSON:", err)
+	}
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("bad JSON. want: %s got: %s", want, got)
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	t.Parallel()
+	schema := into.Object{
+		"id":    into.IntField().Required().Min(1),
+		"count": into.UintField(),
+		"name":  into.StringField().MaxLen(8).Pattern(regexp.MustCompile(`^[a-z]+$`)),
+		"kind":  into.StringField().OneOf("a", "b"),
+		"tags":  into.SliceOf(into.StringField()).MinLen(1),
+		"meta":  into.Object{"ok": into.BoolField()},
+		"any":   into.AnyField(),
+	}
+	got, err := into.JSONSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonEqual(t, got, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer", "minimum": 1},
+			"count": {"type": "integer", "minimum": 0},
+			"name": {"type": "string", "maxLength": 8, "pattern": "^[a-z]+$"},
+			"kind": {"type": "string", "enum": ["a", "b"]},
+			"tags": {"type": "array", "items": {"type": "string"}, "minItems": 1},
+			"meta": {"type": "object", "properties": {"ok": {"type": "boolean"}}},
+			"any": {}
+		},
+		"required": ["id"]
+	}`)
+}
+
+type schemaNode struct {
+	Name     string        `into:"name"`
+	Children []*schemaNode `into:"children,omitempty"`
+}
+
+type schemaEmbed struct {
+	Created time.Time `json:"created"`
+}
+
+type schemaStruct struct {
+	schemaEmbed
+	ID      int            `into:"id"`
+	Score   *float64       `into:"score"`
+	Data    []byte         `into:"data,omitempty"`
+	Pair    [2]uint8       `into:"pair"`
+	Labels  map[string]any `into:"labels,omitempty"`
+	Node    schemaNode     `into:"node"`
+	Ignored string         `into:"-"`
+	Other   any
+}
+
+func TestSchemaOf(t *testing.T) {
+	t.Parallel()
+	got, err := into.JSONSchema(into.SchemaOf(schemaStruct{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonEqual(t, got, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"created": {"type": "string"},
+			"id": {"type": "integer"},
+			"score": {"type": "number"},
+			"data": {"type": "string"},
+			"pair": {"type": "array", "items": {"type": "integer", "minimum": 0}, "minItems": 2, "maxItems": 2},
+			"labels": {"type": "object", "properties": {}},
+			"node": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string"},
+					"children": {"type": "array", "items": {"type": "object", "properties": {}}}
+				},
+				"required": ["name"]
+			},
+			"Other": {}
+		},
+		"required": ["Other", "created", "id", "node", "pair"]
+	}`)
+
+	doc := map[string]any{"created": "2024-01-01", "id": 1.0, "pair": []any{1, 2}, "node": map[string]any{"name": "x"}, "Other": 0}
+	if err := into.SchemaOf(schemaStruct{}).Validate(doc); err != nil {
+		t.Error("unexpected error:", err)
+	}
+}
+
+func TestParseJSONSchema(t *testing.T) {
+	t.Parallel()
+	schema, err := into.ParseJSONSchema([]byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer", "minimum": 1},
+			"name": {"type": ["string", "null"], "pattern": "^[a-z]+$", "maxLength": 4},
+			"kind": {"enum": ["a", 2]},
+			"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2},
+			"price": {"type": "number", "maximum": 10}
+		},
+		"required": ["id", "kind"]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := schema.Coerce(map[string]any{"id": 42.0, "name": "abc", "kind": 2.0, "tags": []any{"x"}, "price": 9})
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	want := map[string]any{"id": 42, "name": "abc", "kind": 2.0, "tags": []any{"x"}, "price": 9.0}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bad result. want: %#v got: %#v", want, got)
+	}
+
+	err = schema.Validate(map[string]any{"id": 0, "name": "ABC", "tags": []any{"x", "y", "z"}, "price": 11})
+	var errs into.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("want Errors, got: %T %v", err, err)
+	}
+	paths := make(map[string]error)
+	for _, err := range errs {
+		paths[err.Path] = err
+	}
+	for path, kind := range map[string]error{
+		"id":    into.ErrRange,
+		"kind":  into.ErrRequired,
+		"name":  into.ErrSyntax,
+		"tags":  into.ErrRange,
+		"price": into.ErrRange,
+	} {
+		if !errors.Is(paths[path], kind) {
+			t.Errorf("at %s: want %v, got: %v", path, kind, paths[path])
+		}
+	}
+	if len(errs) != 5 {
+		t.Errorf("want 5 errors, got: %v", err)
+	}
+}
+
+func TestParseJSONSchemaRoundTrip(t *testing.T) {
+	t.Parallel()
+	data, err := into.JSONSchema(webhookSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := into.ParseJSONSchema(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := into.JSONSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonEqual(t, again, string(data))
+}
+
+func TestParseJSONSchemaErrors(t *testing.T) {
+	t.Parallel()
+	for _, doc := range []string{
+		`[]`,
+		`{"type": "tuple"}`,
+		`{"type": ["string", "integer"]}`,
+		`{"type": "object", "properties": {"a": {"type": 1}}}`,
+		`{"type": "string", "pattern": "("}`,
+	} {
+		if _, err := into.ParseJSONSchema([]byte(doc)); err == nil {
+			t.Errorf("ParseJSONSchema(%s): expected error", doc)
+		}
+	}
+}
+
+func TestParseJSONSchemaNull(t *testing.T) {
+	t.Parallel()
+	for _, doc := range []string{`{"type": "null"}`, `{"type": ["null"]}`} {
+		schema, err := into.ParseJSONSchema([]byte(doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := schema.Validate(nil); err != nil {
+			t.Errorf("%s: unexpected error for nil: %v", doc, err)
+		}
+		if err := schema.Validate(5); !errors.Is(err, into.ErrUnsupportedType) {
+			t.Errorf("%s: want ErrUnsupportedType for 5, got: %v", doc, err)
+		}
+	}
+
+	data := []byte(`{
+		"type": "object",
+		"properties": {"id": {"type": ["integer", "null"]}, "none": {"type": "null"}},
+		"required": ["id", "none"]
+	}`)
+	schema, err := into.ParseJSONSchema(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := schema.Validate(map[string]any{"id": nil, "none": nil}); err != nil {
+		t.Error("unexpected error:", err)
+	}
+	if err := schema.Validate(map[string]any{"id": "x", "none": nil}); into.ErrorPath(err) != "id" {
+		t.Error("want error at id, got:", err)
+	}
+	if err := schema.Validate(map[string]any{"none": nil}); !errors.Is(err, into.ErrRequired) {
+		t.Error("want ErrRequired for missing id, got:", err)
+	}
+	again, err := into.JSONSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jsonEqual(t, again, `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {"id": {"type": ["integer", "null"]}, "none": {"type": "null"}},
+		"required": ["id", "none"]
+	}`)
+}
+
+func TestParseJSONSchemaOptionalNull(t *testing.T) {
+	t.Parallel()
+	schema, err := into.ParseJSONSchema([]byte(`{"type": "object", "properties": {"name": {"type": "string"}, "any": {}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := schema.Validate(map[string]any{}); err != nil {
+		t.Error("unexpected error for missing name:", err)
+	}
+	if err := schema.Validate(map[string]any{"any": nil}); err != nil {
+		t.Error("unexpected error for untyped nil:", err)
+	}
+	err = schema.Validate(map[string]any{"name": nil})
+	if !errors.Is(err, into.ErrUnsupportedType) || into.ErrorPath(err) != "name" {
+		t.Error("want ErrUnsupportedType at name, got:", err)
+	}
+}
@@ -0,0 +1,68 @@
+package into
+
+import "reflect"
+
+// Kind is the kind of coercion that the package applies to a value, as reported by [KindOf].
+type Kind int
+
+const (
+	// KindUnknown is for values that are not numbers, bools, or string-like, such as structs and maps.
+	KindUnknown Kind = iota
+	// KindNil is for nil and nil pointers.
+	KindNil
+	// KindBool is for values with an underlying bool type.
+	KindBool
+	// KindInt is for values with an underlying signed integer type, as coerced by [Int].
+	KindInt
+	// KindUint is for values with an underlying unsigned integer type, as coerced by [Uint].
+	KindUint
+	// KindFloat is for values with an underlying float type, as coerced by [Float].
+	KindFloat
+	// KindString is for string-like values supported by [String].
+	KindString
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindNil:
+		return "nil"
+	case KindBool:
+		return "bool"
+	case KindInt:
+		return "int"
+	case KindUint:
+		return "uint"
+	case KindFloat:
+		return "float"
+	case KindString:
+		return "string"
+	}
+	return "unknown"
+}
+
+// KindOf reports the kind of coercion that the package applies to x.
+// Named types and pointers are classified by their underlying type, so a *time.Duration is [KindInt]
+// and a nil *int is [KindNil].
+// Types that only implement fmt.Stringer or [encoding.TextMarshaler] are [KindString].
+func KindOf(x any) Kind {
+	if v, ok := operand(x); ok {
+		switch v.kind {
+		case reflect.Invalid:
+			return KindNil
+		case reflect.Bool:
+			return KindBool
+		case reflect.Int64:
+			return KindInt
+		case reflect.Uint64:
+			return KindUint
+		case reflect.Float64:
+			return KindFloat
+		case reflect.String:
+			return KindString
+		}
+	}
+	if CanString(x) {
+		return KindString
+	}
+	return KindUnknown
+}
@@ -0,0 +1,146 @@
+package into
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// CanMap returns true if the given value can be coerced to a map using the given key and value converters.
+// [Map] will succeed without panicking if CanMap returns true.
+//
+// See: [Map] for supported types.
+func CanMap[K comparable, V any](x any, key func(any, ...Option) K, val func(any, ...Option) V, options ...Option) bool {
+	_, err := mapOf(x, key, val, options)
+	return err == nil
+}
+
+// Map coerces x into a map, converting each key with key and each value with val (such as [String] or [Int]).
+// Options are passed along to the converters. Map supports the following types:
+//   - map[string]any, map[any]any
+//   - any map type, or pointers to such types, unless [WithoutReflection] is used
+//   - given [WithKeyValuePairs], slices of alternating keys and values (such as []any{"a", 1, "b", 2})
+//     or slices of two-element pairs (such as [][]any{{"a", 1}, {"b", 2}})
+//   - nil, returning a nil map
+//
+// Map will panic with ErrInvalid if the value cannot be coerced,
+// or with [ErrRange] if two keys are the same after conversion (such as "1" and 1 given [Int] and [WithConvertStrings]).
+// If a key or value fails to convert, its error's Path reports the key.
+func Map[K comparable, V any](x any, key func(any, ...Option) K, val func(any, ...Option) V, options ...Option) map[K]V {
+	m, err := mapOf(x, key, val, options)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func mapOf[K comparable, V any](x any, key func(any, ...Option) K, val func(any, ...Option) V, options []Option) (out map[K]V, err error) {
+	var at any
+	failed := false
+	defer func() {
+		if err != nil && failed {
+			err = atPath(err, at, x, mapTypeName[K, V]())
+		}
+	}()
+	defer catch(&err)
+
+	put := func(k, v any) {
+		at, failed = k, true
+		kk := key(k, options...)
+		if _, dup := out[kk]; dup {
+			panic(ErrInvalid{Value: k, Type: mapTypeName[K, V](), Cause: fmt.Errorf("%w: duplicate key %v", ErrRange, kk)})
+		}
+		out[kk] = val(v, options...)
+		failed = false
+	}
+
+	switch v := x.(type) {
+	case map[string]any:
+		if v == nil {
+			return nil, nil
+		}
+		out = make(map[K]V, len(v))
+		for k, elem := range v {
+			put(k, elem)
+		}
+		return out, nil
+	case map[any]any:
+		if v == nil {
+			return nil, nil
+		}
+		out = make(map[K]V, len(v))
+		for k, elem := range v {
+			put(k, elem)
+		}
+		return out, nil
+	case nil:
+		return nil, nil
+	}
+
+	if !should(options, skipReflect) {
+		rv := reflect.ValueOf(x)
+		for rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return nil, nil
+			}
+			rv = rv.Elem()
+		}
+		switch rv.Kind() {
+		case reflect.Map:
+			if rv.IsNil() {
+				return nil, nil
+			}
+			out = make(map[K]V, rv.Len())
+			iter := rv.MapRange()
+			for iter.Next() {
+				put(iter.Key().Interface(), iter.Value().Interface())
+			}
+			return out, nil
+		case reflect.Slice, reflect.Array:
+			if !should(options, keyValuePairs) {
+				break
+			}
+			out = make(map[K]V, rv.Len()/2)
+			if err := putPairs(rv, put); err != nil {
+				return nil, ErrInvalid{Value: x, Type: mapTypeName[K, V](), Cause: err}
+			}
+			return out, nil
+		}
+	} else if pairs, ok := x.([]any); ok && should(options, keyValuePairs) {
+		out = make(map[K]V, len(pairs)/2)
+		if err := putPairs(reflect.ValueOf(pairs), put); err != nil {
+			return nil, ErrInvalid{Value: x, Type: mapTypeName[K, V](), Cause: err}
+		}
+		return out, nil
+	}
+
+	return nil, ErrInvalid{Value: x, Type: mapTypeName[K, V]()}
+}
+
+// putPairs calls put for each key/value pair in rv, a slice of alternating keys and values or a slice of pairs.
+func putPairs(rv reflect.Value, put func(k, v any)) error {
+	n := rv.Len()
+	if n == 0 {
+		return nil
+	}
+	if first := reflect.ValueOf(rv.Index(0).Interface()); first.Kind() == reflect.Slice || first.Kind() == reflect.Array {
+		for i := 0; i < n; i++ {
+			pair := reflect.ValueOf(rv.Index(i).Interface())
+			if (pair.Kind() != reflect.Slice && pair.Kind() != reflect.Array) || pair.Len() != 2 {
+				return fmt.Errorf("%w: index %d is not a key/value pair", ErrSyntax, i)
+			}
+			put(pair.Index(0).Interface(), pair.Index(1).Interface())
+		}
+		return nil
+	}
+	if n%2 != 0 {
+		return fmt.Errorf("%w: odd number of elements in key/value list", ErrSyntax)
+	}
+	for i := 0; i < n; i += 2 {
+		put(rv.Index(i).Interface(), rv.Index(i+1).Interface())
+	}
+	return nil
+}
+
+func mapTypeName[K comparable, V any]() string {
+	return "map[" + reflect.TypeOf((*K)(nil)).Elem().String() + "]" + reflect.TypeOf((*V)(nil)).Elem().String()
+}
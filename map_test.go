@@ -0,0 +1,163 @@
+package into_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		input any
+		want  map[string]int
+		opts  []into.Option
+		err   bool
+	}{
+		{
+			name:  "map[string]any",
+			input: map[string]any{"a": 1, "b": int64(2)},
+			want:  map[string]int{"a": 1, "b": 2},
+		},
+		{
+			name:  "map[any]any",
+			input: map[any]any{"a": 1, myString("b"): int8(2)},
+			want:  map[string]int{"a": 1, "b": 2},
+		},
+		{
+			name:  "typed map",
+			input: map[myString]myInt{"a": 1},
+			want:  map[string]int{"a": 1},
+		},
+		{
+			name:  "pointer to map",
+			input: &map[string]int{"a": 1},
+			want:  map[string]int{"a": 1},
+		},
+		{
+			name:  "nil",
+			input: nil,
+			want:  nil,
+		},
+		{
+			name:  "nil map",
+			input: map[string]any(nil),
+			want:  nil,
+		},
+		{
+			name:  "typed map without reflection",
+			input: map[string]int{"a": 1},
+			opts:  []into.Option{into.WithoutReflection()},
+			err:   true,
+		},
+		{
+			name:  "alternating pairs",
+			input: []any{"a", 1, "b", 2},
+			want:  map[string]int{"a": 1, "b": 2},
+			opts:  []into.Option{into.WithKeyValuePairs()},
+		},
+		{
+			name:  "slice of pairs",
+			input: [][]any{{"a", 1}, {"b", 2}},
+			want:  map[string]int{"a": 1, "b": 2},
+			opts:  []into.Option{into.WithKeyValuePairs()},
+		},
+		{
+			name:  "odd pairs",
+			input: []any{"a", 1, "b"},
+			opts:  []into.Option{into.WithKeyValuePairs()},
+			err:   true,
+		},
+		{
+			name:  "pairs without option",
+			input: []any{"a", 1},
+			err:   true,
+		},
+		{
+			name:  "bad value",
+			input: map[string]any{"a": "x"},
+			err:   true,
+		},
+		{
+			name:  "bad key",
+			input: map[any]any{1.5: 1},
+			err:   true,
+		},
+		{
+			name:  "scalar",
+			input: 42,
+			err:   true,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := into.Maybe(func(x any, options ...into.Option) map[string]int {
+				return into.Map(x, into.String, into.Int, options...)
+			}, test.input, test.opts...)
+			if (err != nil) != test.err {
+				t.Fatal("unexpected error state:", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Error("bad return value. want:", test.want, "got:", got)
+			}
+		})
+	}
+}
+
+func TestMapKeyError(t *testing.T) {
+	t.Parallel()
+	err := into.Try(func() {
+		into.Map(map[string]any{"price": "free"}, into.String, into.Float)
+	})
+	var ei into.ErrInvalid
+	if !errors.As(err, &ei) || ei.Type != "float" {
+		t.Fatal("unexpected error:", err)
+	}
+	if ei.Path != "price" {
+		t.Error("error should report the key. got path:", ei.Path)
+	}
+
+	err = into.Try(func() {
+		into.Map(map[string]any{"a": []any{1, "x"}}, into.String, func(x any, options ...into.Option) []int {
+			return into.Slice(x, into.Int, options...)
+		})
+	})
+	if got := into.ErrorPath(err); got != "a[1]" {
+		t.Error("bad nested path:", got)
+	}
+}
+
+func TestMapDuplicateKey(t *testing.T) {
+	t.Parallel()
+	for i := 0; i < 10; i++ {
+		_, err := into.Maybe(func(x any, options ...into.Option) map[int]int {
+			return into.Map(x, into.Int, into.Int, options...)
+		}, map[any]any{"1": 1, 1: 2}, into.WithConvertStrings())
+		var ei into.ErrInvalid
+		if !errors.As(err, &ei) || !errors.Is(err, into.ErrRange) || errors.Is(err, into.ErrSyntax) {
+			t.Fatal("expected ErrInvalid with ErrRange, got:", err)
+		}
+		// either key may be seen second, depending on map iteration order
+		if ei.Path != "1" && ei.Path != "[1]" {
+			t.Error("error should report the key. got path:", ei.Path)
+		}
+	}
+
+	if into.CanMap([]any{"a", 1, "a", 2}, into.String, into.Int, into.WithKeyValuePairs()) {
+		t.Error("expected duplicate keys in pairs to fail")
+	}
+}
+
+func TestCanMap(t *testing.T) {
+	t.Parallel()
+	if !into.CanMap(map[any]any{"a": "b"}, into.String, into.String) {
+		t.Error("failed but should have succeeded")
+	}
+	if into.CanMap(map[any]any{"a": 1}, into.String, into.String) {
+		t.Error("succeeded but should have failed")
+	}
+}
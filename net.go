@@ -0,0 +1,301 @@
+package into
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/netip"
+	"reflect"
+)
+
+// CanAddr returns true if the given value can be coerced to an IP address.
+// [Addr] will succeed without panicking if CanAddr returns true.
+//
+// See: [Addr] for supported types.
+func CanAddr(x any, options ...Option) bool {
+	_, err := addr(x, options)
+	return err == nil
+}
+
+// Addr coerces x into an IP address, supporting the following types:
+//   - netip.Addr, *netip.Addr
+//   - net.IP (IPv4-mapped addresses are unmapped)
+//   - []byte of length 4 (IPv4) or 16 (IPv6), unless given [WithConvertStrings] and it parses as text
+//   - uint32, as an IPv4 address in network byte order
+//   - given [WithConvertStrings], any string-like type supported by [String]
+//   - nil
+//
+// Addr will panic with ErrInvalid if the value cannot be coerced.
+func Addr(x any, options ...Option) netip.Addr {
+	ip, err := addr(x, options)
+	if err != nil {
+		panic(err)
+	}
+	return ip
+}
+
+// AddrOK is like [Addr], but reports whether the conversion succeeded instead of panicking.
+func AddrOK(x any, options ...Option) (netip.Addr, bool) {
+	v, err := addr(x, options)
+	return v, err == nil
+}
+
+// AddrErr is like [Addr], but returns an error instead of panicking.
+func AddrErr(x any, options ...Option) (netip.Addr, error) {
+	return addr(x, options)
+}
+
+// AddrValue is like [Addr], but takes a reflect.Value such as a struct field.
+func AddrValue(rv reflect.Value, options ...Option) netip.Addr {
+	v, err := fromValue(rv, "netip.Addr", options, addr)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func addr(x any, options []Option) (netip.Addr, error) {
+	switch x := x.(type) {
+	case netip.Addr:
+		return x, nil
+	case *netip.Addr:
+		if x == nil {
+			return fallbackFor[netip.Addr](options, "netip.Addr")
+		}
+		return *x, nil
+	case net.IP:
+		if x == nil {
+			return fallbackFor[netip.Addr](options, "netip.Addr")
+		}
+		if ip, ok := netip.AddrFromSlice(x); ok {
+			return ip.Unmap(), nil
+		}
+		return netip.Addr{}, ErrInvalid{Value: x, Type: "netip.Addr", Cause: ErrSyntax}
+	case []byte:
+		// given WithConvertStrings, text such as "1::1" (also 4 bytes long) takes precedence over raw bytes
+		if should(options, convertStrings) {
+			if ip, err := netip.ParseAddr(string(x)); err == nil {
+				return ip, nil
+			}
+		}
+		if len(x) == net.IPv4len || len(x) == net.IPv6len {
+			ip, _ := netip.AddrFromSlice(x)
+			return ip, nil
+		}
+	case uint32:
+		var b [4]byte
+		binary.BigEndian.PutUint32(b[:], x)
+		return netip.AddrFrom4(b), nil
+	case nil:
+		return fallbackFor[netip.Addr](options, "netip.Addr")
+	}
+
+	if should(options, convertStrings) && CanString(x, options...) {
+		str, err := stringFor(x, options)
+		if err != nil {
+			return netip.Addr{}, err
+		}
+		if str == "" {
+			return fallbackFor[netip.Addr](options, "netip.Addr")
+		}
+		ip, err := netip.ParseAddr(str)
+		if err != nil {
+			return netip.Addr{}, ErrInvalid{Value: x, Type: "netip.Addr", Cause: kindError{ErrSyntax, err}}
+		}
+		return ip, nil
+	}
+
+	return netip.Addr{}, ErrInvalid{Value: x, Type: "netip.Addr"}
+}
+
+// CanPrefix returns true if the given value can be coerced to an IP network prefix.
+// [Prefix] will succeed without panicking if CanPrefix returns true.
+//
+// See: [Prefix] for supported types.
+func CanPrefix(x any, options ...Option) bool {
+	_, err := prefix(x, options)
+	return err == nil
+}
+
+// Prefix coerces x into an IP network prefix, supporting the following types:
+//   - netip.Prefix, *netip.Prefix
+//   - net.IPNet, *net.IPNet (masks must be canonical)
+//   - given [WithConvertStrings], any string-like type supported by [String] in CIDR notation
+//   - nil
+//
+// Prefix will panic with ErrInvalid if the value cannot be coerced.
+func Prefix(x any, options ...Option) netip.Prefix {
+	p, err := prefix(x, options)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// PrefixOK is like [Prefix], but reports whether the conversion succeeded instead of panicking.
+func PrefixOK(x any, options ...Option) (netip.Prefix, bool) {
+	v, err := prefix(x, options)
+	return v, err == nil
+}
+
+// PrefixErr is like [Prefix], but returns an error instead of panicking.
+func PrefixErr(x any, options ...Option) (netip.Prefix, error) {
+	return prefix(x, options)
+}
+
+// PrefixValue is like [Prefix], but takes a reflect.Value such as a struct field.
+func PrefixValue(rv reflect.Value, options ...Option) netip.Prefix {
+	v, err := fromValue(rv, "netip.Prefix", options, prefix)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func prefix(x any, options []Option) (netip.Prefix, error) {
+	switch x := x.(type) {
+	case netip.Prefix:
+		return x, nil
+	case *netip.Prefix:
+		if x == nil {
+			return fallbackFor[netip.Prefix](options, "netip.Prefix")
+		}
+		return *x, nil
+	case net.IPNet:
+		return ipnetPrefix(&x)
+	case *net.IPNet:
+		if x == nil {
+			return fallbackFor[netip.Prefix](options, "netip.Prefix")
+		}
+		return ipnetPrefix(x)
+	case nil:
+		return fallbackFor[netip.Prefix](options, "netip.Prefix")
+	}
+
+	if should(options, convertStrings) && CanString(x, options...) {
+		str, err := stringFor(x, options)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		if str == "" {
+			return fallbackFor[netip.Prefix](options, "netip.Prefix")
+		}
+		p, err := netip.ParsePrefix(str)
+		if err != nil {
+			return netip.Prefix{}, ErrInvalid{Value: x, Type: "netip.Prefix", Cause: kindError{ErrSyntax, err}}
+		}
+		return p, nil
+	}
+
+	return netip.Prefix{}, ErrInvalid{Value: x, Type: "netip.Prefix"}
+}
+
+func ipnetPrefix(n *net.IPNet) (netip.Prefix, error) {
+	ip, ok := netip.AddrFromSlice(n.IP)
+	if !ok {
+		return netip.Prefix{}, ErrInvalid{Value: n, Type: "netip.Prefix", Cause: ErrSyntax}
+	}
+	ones, bits := n.Mask.Size()
+	if bits == 0 {
+		return netip.Prefix{}, ErrInvalid{Value: n, Type: "netip.Prefix", Cause: fmt.Errorf("%w: non-canonical mask %v", ErrSyntax, n.Mask)}
+	}
+	switch {
+	case bits == 8*net.IPv4len && ip.Unmap().Is4():
+		ip = ip.Unmap()
+	case bits == 8*net.IPv6len && ip.Is6():
+	default:
+		return netip.Prefix{}, ErrInvalid{Value: n, Type: "netip.Prefix", Cause: fmt.Errorf("%w: %d-bit mask for address %v", ErrSyntax, bits, ip)}
+	}
+	p := netip.PrefixFrom(ip, ones)
+	if !p.IsValid() {
+		return netip.Prefix{}, ErrInvalid{Value: n, Type: "netip.Prefix", Cause: ErrSyntax}
+	}
+	return p, nil
+}
+
+// CanAddrPort returns true if the given value can be coerced to an IP address and port.
+// [AddrPort] will succeed without panicking if CanAddrPort returns true.
+//
+// See: [AddrPort] for supported types.
+func CanAddrPort(x any, options ...Option) bool {
+	_, err := addrPort(x, options)
+	return err == nil
+}
+
+// AddrPort coerces x into an IP address and port, supporting the following types:
+//   - netip.AddrPort, *netip.AddrPort
+//   - *net.TCPAddr, *net.UDPAddr
+//   - given [WithConvertStrings], any string-like type supported by [String]
+//   - nil
+//
+// AddrPort will panic with ErrInvalid if the value cannot be coerced.
+func AddrPort(x any, options ...Option) netip.AddrPort {
+	ap, err := addrPort(x, options)
+	if err != nil {
+		panic(err)
+	}
+	return ap
+}
+
+// AddrPortOK is like [AddrPort], but reports whether the conversion succeeded instead of panicking.
+func AddrPortOK(x any, options ...Option) (netip.AddrPort, bool) {
+	v, err := addrPort(x, options)
+	return v, err == nil
+}
+
+// AddrPortErr is like [AddrPort], but returns an error instead of panicking.
+func AddrPortErr(x any, options ...Option) (netip.AddrPort, error) {
+	return addrPort(x, options)
+}
+
+// AddrPortValue is like [AddrPort], but takes a reflect.Value such as a struct field.
+func AddrPortValue(rv reflect.Value, options ...Option) netip.AddrPort {
+	v, err := fromValue(rv, "netip.AddrPort", options, addrPort)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func addrPort(x any, options []Option) (netip.AddrPort, error) {
+	switch x := x.(type) {
+	case netip.AddrPort:
+		return x, nil
+	case *netip.AddrPort:
+		if x == nil {
+			return fallbackFor[netip.AddrPort](options, "netip.AddrPort")
+		}
+		return *x, nil
+	case *net.TCPAddr:
+		if x == nil {
+			return fallbackFor[netip.AddrPort](options, "netip.AddrPort")
+		}
+		ap := x.AddrPort()
+		return netip.AddrPortFrom(ap.Addr().Unmap(), ap.Port()), nil
+	case *net.UDPAddr:
+		if x == nil {
+			return fallbackFor[netip.AddrPort](options, "netip.AddrPort")
+		}
+		ap := x.AddrPort()
+		return netip.AddrPortFrom(ap.Addr().Unmap(), ap.Port()), nil
+	case nil:
+		return fallbackFor[netip.AddrPort](options, "netip.AddrPort")
+	}
+
+	if should(options, convertStrings) && CanString(x, options...) {
+		str, err := stringFor(x, options)
+		if err != nil {
+			return netip.AddrPort{}, err
+		}
+		if str == "" {
+			return fallbackFor[netip.AddrPort](options, "netip.AddrPort")
+		}
+		ap, err := netip.ParseAddrPort(str)
+		if err != nil {
+			return netip.AddrPort{}, ErrInvalid{Value: x, Type: "netip.AddrPort", Cause: kindError{ErrSyntax, err}}
+		}
+		return ap, nil
+	}
+
+	return netip.AddrPort{}, ErrInvalid{Value: x, Type: "netip.AddrPort"}
+}
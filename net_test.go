@@ -0,0 +1,263 @@
+package into_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestAddr(t *testing.T) {
+	t.Parallel()
+	v4 := netip.MustParseAddr("192.0.2.1")
+	v6 := netip.MustParseAddr("2001:db8::1")
+	tests := table[netip.Addr]{
+		{
+			name:  "netip.Addr",
+			input: v4,
+			want:  v4,
+		},
+		{
+			name:  "*netip.Addr",
+			input: into.Ptr(v6),
+			want:  v6,
+		},
+		{
+			name:  "*netip.Addr(nil)",
+			input: (*netip.Addr)(nil),
+			want:  netip.Addr{},
+		},
+		{
+			name:  "net.IP",
+			input: net.ParseIP("192.0.2.1"),
+			want:  v4,
+		},
+		{
+			name:  "net.IP v6",
+			input: net.ParseIP("2001:db8::1"),
+			want:  v6,
+		},
+		{
+			name:  "[]byte of length 4",
+			input: []byte{192, 0, 2, 1},
+			want:  v4,
+		},
+		{
+			name:  "[]byte of length 16",
+			input: v6.AsSlice(),
+			want:  v6,
+		},
+		{
+			name:  "uint32",
+			input: uint32(0xc0000201),
+			want:  v4,
+		},
+		{
+			name:  "string conversion",
+			input: "2001:db8::1",
+			want:  v6,
+			opts:  []into.Option{into.WithConvertStrings()},
+		},
+		{
+			name:  "[]byte string conversion",
+			input: []byte("192.0.2.1"),
+			want:  v4,
+			opts:  []into.Option{into.WithConvertStrings()},
+		},
+		{
+			name:  "4-byte text conversion",
+			input: []byte("1::1"),
+			want:  netip.MustParseAddr("1::1"),
+			opts:  []into.Option{into.WithConvertStrings()},
+		},
+		{
+			name:  "[]byte of length 4 with conversion",
+			input: []byte{192, 0, 2, 1},
+			want:  v4,
+			opts:  []into.Option{into.WithConvertStrings()},
+		},
+		{
+			name:  "string without conversion",
+			input: "192.0.2.1",
+			err:   into.ErrInvalid{Value: "192.0.2.1", Type: "netip.Addr"},
+		},
+		{
+			name:  "bad string",
+			input: "192.0.2",
+			opts:  []into.Option{into.WithConvertStrings()},
+			err:   into.ErrInvalid{Value: "192.0.2", Type: "netip.Addr"},
+		},
+		{
+			name:  "fallback",
+			input: nil,
+			want:  v4,
+			opts:  []into.Option{into.WithFallback(v4)},
+		},
+		{
+			name:  "empty string fallback",
+			input: "",
+			want:  v4,
+			opts:  []into.Option{into.WithConvertStrings(), into.WithFallback(v4)},
+		},
+		{
+			name:  "invalid type",
+			input: 42,
+			err:   into.ErrInvalid{Value: 42, Type: "netip.Addr"},
+		},
+	}
+	tests.Run(t, into.Addr)
+	tests.RunErr(t, into.AddrErr)
+}
+
+func TestPrefix(t *testing.T) {
+	t.Parallel()
+	v4 := netip.MustParsePrefix("192.0.2.0/24")
+	v6 := netip.MustParsePrefix("2001:db8::/32")
+	_, v4net, _ := net.ParseCIDR("192.0.2.0/24")
+	_, v6net, _ := net.ParseCIDR("2001:db8::/32")
+	tests := table[netip.Prefix]{
+		{
+			name:  "netip.Prefix",
+			input: v4,
+			want:  v4,
+		},
+		{
+			name:  "*netip.Prefix",
+			input: into.Ptr(v6),
+			want:  v6,
+		},
+		{
+			name:  "*net.IPNet",
+			input: v4net,
+			want:  v4,
+		},
+		{
+			name:  "net.IPNet",
+			input: *v6net,
+			want:  v6,
+		},
+		{
+			name:  "*net.IPNet(nil)",
+			input: (*net.IPNet)(nil),
+			want:  netip.Prefix{},
+		},
+		{
+			name:  "non-canonical mask",
+			input: &net.IPNet{IP: net.IPv4(192, 0, 2, 0), Mask: net.IPv4Mask(255, 0, 255, 0)},
+			err:   into.ErrInvalid{Type: "netip.Prefix"},
+		},
+		{
+			name:  "IPv4 address with IPv6 mask",
+			input: &net.IPNet{IP: net.IP{192, 0, 2, 0}, Mask: net.CIDRMask(120, 128)},
+			err:   into.ErrInvalid{Type: "netip.Prefix"},
+		},
+		{
+			name:  "IPv6 address with IPv4 mask",
+			input: &net.IPNet{IP: net.ParseIP("2001:db8::"), Mask: net.CIDRMask(24, 32)},
+			err:   into.ErrInvalid{Type: "netip.Prefix"},
+		},
+		{
+			name:  "string conversion",
+			input: "2001:db8::/32",
+			want:  v6,
+			opts:  []into.Option{into.WithConvertStrings()},
+		},
+		{
+			name:  "bad string",
+			input: "192.0.2.0",
+			opts:  []into.Option{into.WithConvertStrings()},
+			err:   into.ErrInvalid{Value: "192.0.2.0", Type: "netip.Prefix"},
+		},
+		{
+			name:  "fallback",
+			input: nil,
+			want:  v4,
+			opts:  []into.Option{into.WithFallback(v4)},
+		},
+	}
+	tests.Run(t, into.Prefix)
+	tests.RunErr(t, into.PrefixErr)
+}
+
+func TestAddrPort(t *testing.T) {
+	t.Parallel()
+	v4 := netip.MustParseAddrPort("192.0.2.1:80")
+	v6 := netip.MustParseAddrPort("[2001:db8::1]:443")
+	tests := table[netip.AddrPort]{
+		{
+			name:  "netip.AddrPort",
+			input: v4,
+			want:  v4,
+		},
+		{
+			name:  "*netip.AddrPort",
+			input: into.Ptr(v6),
+			want:  v6,
+		},
+		{
+			name:  "*net.TCPAddr",
+			input: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 80},
+			want:  v4,
+		},
+		{
+			name:  "*net.UDPAddr",
+			input: &net.UDPAddr{IP: net.ParseIP("2001:db8::1"), Port: 443},
+			want:  v6,
+		},
+		{
+			name:  "*net.TCPAddr(nil)",
+			input: (*net.TCPAddr)(nil),
+			want:  netip.AddrPort{},
+		},
+		{
+			name:  "string conversion",
+			input: "[2001:db8::1]:443",
+			want:  v6,
+			opts:  []into.Option{into.WithConvertStrings()},
+		},
+		{
+			name:  "missing port",
+			input: "192.0.2.1",
+			opts:  []into.Option{into.WithConvertStrings()},
+			err:   into.ErrInvalid{Value: "192.0.2.1", Type: "netip.AddrPort"},
+		},
+		{
+			name:  "fallback",
+			input: nil,
+			want:  v4,
+			opts:  []into.Option{into.WithFallback(v4)},
+		},
+	}
+	tests.Run(t, into.AddrPort)
+	tests.RunErr(t, into.AddrPortErr)
+}
+
+func TestCanAddr(t *testing.T) {
+	t.Parallel()
+	if !into.CanAddr("192.0.2.1", into.WithConvertStrings()) {
+		t.Error("failed but should have succeeded")
+	}
+	if into.CanAddr("192.0.2.1") {
+		t.Error("succeeded but should have failed without WithConvertStrings")
+	}
+	if into.CanAddr([]byte{1, 2, 3}) {
+		t.Error("succeeded but should have failed for 3-byte slice")
+	}
+	if !into.CanPrefix("192.0.2.0/24", into.WithConvertStrings()) {
+		t.Error("failed but should have succeeded")
+	}
+	if into.CanAddrPort("192.0.2.1", into.WithConvertStrings()) {
+		t.Error("succeeded but should have failed for missing port")
+	}
+}
+
+func TestAddrInvalidFallback(t *testing.T) {
+	t.Parallel()
+	err := into.Try(func() {
+		into.Addr(nil, into.WithFallback("192.0.2.1"))
+	})
+	if err == nil {
+		t.Error("expected error (panic)")
+	}
+}
@@ -18,6 +18,11 @@ const (
 	convertStrings flags = iota
 	skipReflect
 	skipMarshalCheck
+	absoluteURL
+	foldCase
+	scalarAsSlice
+	keyValuePairs
+	stringValues
 )
 
 type fallbackValue struct{ x any }
@@ -41,8 +46,44 @@ func WithoutReflection() Option {
 	return skipReflect
 }
 
+// WithCaseInsensitive enables case-insensitive matching of names, such as [Enum] members.
+func WithCaseInsensitive() Option {
+	return foldCase
+}
+
+// WithScalarAsSlice makes [Slice] accept a single non-slice value, returning it as a one-element slice.
+func WithScalarAsSlice() Option {
+	return scalarAsSlice
+}
+
+// WithKeyValuePairs makes [Map] accept slices of key/value pairs.
+func WithKeyValuePairs() Option {
+	return keyValuePairs
+}
+
+// WithStringValues makes [Encode] convert every value to a string, for form or URL output.
+func WithStringValues() Option {
+	return stringValues
+}
+
 // WithoutMarshalerCheck is an option that skips a check in [CanString] and similar
 // that runs [encoding.TextMarshaler]'s marshal or [strconv] conversions to ensure they don't return errors.
 func WithoutMarshalerCheck() Option {
 	return skipMarshalCheck
 }
+
+// fallbackFor returns the fallback value specified by [WithFallback], or the zero value of T.
+// It returns ErrInvalid wrapping [ErrBadFallback] if the fallback is not a T.
+func fallbackFor[T any](options []Option, typ string) (T, error) {
+	var null T
+	for _, opt := range options {
+		if opt, ok := opt.(fallbackValue); ok {
+			null, ok = opt.x.(T)
+			if !ok {
+				return null, ErrInvalid{Value: opt.x, Type: typ, Cause: ErrBadFallback}
+			}
+			break
+		}
+	}
+	return null, nil
+}
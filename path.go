@@ -0,0 +1,275 @@
+package into
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrPath is an error returned when a path could not be resolved.
+type ErrPath struct {
+	// Path is the full path being resolved.
+	Path string
+	// Segment is the path segment that could not be resolved.
+	Segment string
+	// Value is the value in which Segment was looked up.
+	Value any
+
+	// Cause is non-nil if the path itself is malformed or the segment is an invalid key.
+	Cause error
+}
+
+func (err ErrPath) Error() string {
+	var extra string
+	if err.Cause != nil {
+		extra = "; " + err.Cause.Error()
+	}
+	if err.Segment == "" {
+		return fmt.Sprintf("into: bad path %q%s", err.Path, extra)
+	}
+	return fmt.Sprintf("into: path %q: cannot resolve %q in value of type %T%s", err.Path, err.Segment, err.Value, extra)
+}
+
+func (err ErrPath) Unwrap() error {
+	return err.Cause
+}
+
+// Get looks up the value at path inside of root, reporting whether it was found.
+// Paths are made of names separated by dots, and indexes or quoted keys in brackets,
+// such as "a.b[0].c" or `a["b.c"]`.
+// Get walks maps (converting names to keys of any string, integer, or float kind),
+// slices, arrays, struct fields (named by the same rules as [Decode]), pointers, and interfaces.
+func Get(root any, path string) (any, bool) {
+	v, err := get(root, path)
+	return v, err == nil
+}
+
+// GetString looks up the value at path inside of root and coerces it with [String].
+// It will panic with ErrPath if the path could not be resolved, or ErrInvalid with its Path set if the value cannot be coerced.
+func GetString(root any, path string, options ...Option) string {
+	return getAs(root, path, String, options)
+}
+
+// GetInt looks up the value at path inside of root and coerces it with [Int].
+// It will panic with ErrPath if the path could not be resolved, or ErrInvalid with its Path set if the value cannot be coerced.
+func GetInt(root any, path string, options ...Option) int {
+	return getAs(root, path, Int, options)
+}
+
+// GetUint looks up the value at path inside of root and coerces it with [Uint].
+// It will panic with ErrPath if the path could not be resolved, or ErrInvalid with its Path set if the value cannot be coerced.
+func GetUint(root any, path string, options ...Option) uint {
+	return getAs(root, path, Uint, options)
+}
+
+// GetFloat looks up the value at path inside of root and coerces it with [Float].
+// It will panic with ErrPath if the path could not be resolved, or ErrInvalid with its Path set if the value cannot be coerced.
+func GetFloat(root any, path string, options ...Option) float64 {
+	return getAs(root, path, Float, options)
+}
+
+func getAs[T any](root any, path string, conv func(any, ...Option) T, options []Option) T {
+	defer func() {
+		if ex := recover(); ex != nil {
+			if invalid, ok := ex.(ErrInvalid); ok {
+				invalid.Path = joinPaths(path, invalid.Path)
+				ex = invalid
+			}
+			panic(ex)
+		}
+	}()
+	return conv(mustGet(root, path), options...)
+}
+
+func mustGet(root any, path string) any {
+	v, err := get(root, path)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+type segment struct {
+	name  string
+	index int // -1 if name is a key
+}
+
+func (seg segment) String() string {
+	if seg.index >= 0 {
+		return "[" + strconv.Itoa(seg.index) + "]"
+	}
+	return seg.name
+}
+
+func get(root any, path string) (any, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, ErrPath{Path: path, Cause: err}
+	}
+	cur := root
+	for _, seg := range segments {
+		next, ok, err := step(cur, seg)
+		if !ok {
+			return nil, ErrPath{Path: path, Segment: seg.String(), Value: cur, Cause: err}
+		}
+		cur = next
+	}
+	return cur, nil
+}
+
+func parsePath(path string) ([]segment, error) {
+	var segments []segment
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			if i == 0 || i == len(path)-1 || path[i+1] == '.' || path[i+1] == '[' {
+				return nil, fmt.Errorf("unexpected '.' at offset %d", i)
+			}
+			i++
+		case '[':
+			if i+1 < len(path) && (path[i+1] == '"' || path[i+1] == '\'') {
+				// quoted key, which may contain '.' or ']'
+				end := strings.IndexByte(path[i+2:], path[i+1])
+				if end == -1 || i+2+end+1 >= len(path) || path[i+2+end+1] != ']' {
+					return nil, fmt.Errorf("bad quoted key at offset %d", i)
+				}
+				segments = append(segments, segment{name: path[i+2 : i+2+end], index: -1})
+				i += 2 + end + 2
+				continue
+			}
+			end := strings.IndexByte(path[i:], ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unclosed '[' at offset %d", i)
+			}
+			inner := path[i+1 : i+end]
+			n, err := strconv.Atoi(inner)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("bad index %q at offset %d", inner, i)
+			}
+			segments = append(segments, segment{name: inner, index: n})
+			i += end + 1
+		default:
+			end := strings.IndexAny(path[i:], ".[")
+			if end == -1 {
+				end = len(path) - i
+			}
+			segments = append(segments, segment{name: path[i : i+end], index: -1})
+			i += end
+		}
+	}
+	return segments, nil
+}
+
+// step resolves a single path segment inside of x.
+func step(x any, seg segment) (any, bool, error) {
+	switch x := x.(type) {
+	case map[string]any:
+		v, ok := x[seg.name]
+		return v, ok, nil
+	case []any:
+		idx, ok := seg.sliceIndex()
+		if !ok || idx >= len(x) {
+			return nil, false, nil
+		}
+		return x[idx], true, nil
+	case nil:
+		return nil, false, nil
+	}
+
+	rv := reflect.ValueOf(x)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil, false, nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Map:
+		key, err := mapKey(rv.Type().Key(), seg.name)
+		if err != nil {
+			return nil, false, err
+		}
+		v := rv.MapIndex(key)
+		if !v.IsValid() && rv.Type().Key().Kind() == reflect.Interface {
+			// maps from YAML decoders and the like may have integer keys
+			if n, err := strconv.Atoi(seg.name); err == nil {
+				v = rv.MapIndex(reflect.ValueOf(n))
+			}
+		}
+		if !v.IsValid() {
+			return nil, false, nil
+		}
+		return v.Interface(), true, nil
+	case reflect.Slice, reflect.Array:
+		idx, ok := seg.sliceIndex()
+		if !ok || idx >= rv.Len() {
+			return nil, false, nil
+		}
+		return rv.Index(idx).Interface(), true, nil
+	case reflect.Struct:
+		if seg.index >= 0 {
+			return nil, false, nil
+		}
+		for _, field := range planFor(rv.Type()) {
+			if field.name == seg.name {
+				fv, ok := fieldByIndexNoAlloc(rv, field.index)
+				if !ok || !fv.CanInterface() {
+					return nil, false, nil
+				}
+				return fv.Interface(), true, nil
+			}
+		}
+	}
+	return nil, false, nil
+}
+
+// sliceIndex returns the segment as a slice index, allowing for dotted indexes like "a.0".
+func (seg segment) sliceIndex() (int, bool) {
+	if seg.index >= 0 {
+		return seg.index, true
+	}
+	n, err := strconv.Atoi(seg.name)
+	return n, err == nil && n >= 0
+}
+
+// mapKey converts a path segment into a key of the given map key type.
+func mapKey(kt reflect.Type, name string) (reflect.Value, error) {
+	key := reflect.New(kt).Elem()
+	switch kt.Kind() {
+	case reflect.String:
+		key.SetString(name)
+	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
+		n, err := strconv.ParseInt(name, 10, kt.Bits())
+		if err != nil {
+			return key, err
+		}
+		key.SetInt(n)
+	case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, kt.Bits())
+		if err != nil {
+			return key, err
+		}
+		key.SetUint(n)
+	case reflect.Float64, reflect.Float32:
+		f, err := strconv.ParseFloat(name, kt.Bits())
+		if err != nil {
+			return key, err
+		}
+		key.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(name)
+		if err != nil {
+			return key, err
+		}
+		key.SetBool(b)
+	case reflect.Interface:
+		if !reflect.TypeOf(name).Implements(kt) {
+			return key, ErrInvalid{Value: name, Type: kt.String()}
+		}
+		key.Set(reflect.ValueOf(name))
+	default:
+		return key, ErrInvalid{Value: name, Type: kt.String()}
+	}
+	return key, nil
+}
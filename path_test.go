@@ -0,0 +1,107 @@
+package into_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+type pathSecret struct {
+	User     string
+	Password string `into:"-"`
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	root := map[string]any{
+		"a": map[string]any{
+			"b": []any{
+				map[string]any{"c": 42},
+			},
+		},
+		"yaml":   map[any]any{1: "one", "two": 2},
+		"ints":   map[int]string{3: "three"},
+		"typed":  []int{1, 2, 3},
+		"array":  [2]string{"x", "y"},
+		"struct": &decodeItem{Name: "cat", Price: 9.5},
+		"secret": pathSecret{User: "cat", Password: "hunter2"},
+		"dotted": map[string]any{"x.y": "z", "p]": "q"},
+		"nil":    nil,
+	}
+	tests := []struct {
+		path string
+		want any
+		ok   bool
+	}{
+		{path: "a.b[0].c", want: 42, ok: true},
+		{path: "a.b.0.c", want: 42, ok: true},
+		{path: "yaml.1", want: "one", ok: true},
+		{path: "yaml.two", want: 2, ok: true},
+		{path: "ints.3", want: "three", ok: true},
+		{path: "ints.x", ok: false},
+		{path: "typed[2]", want: 3, ok: true},
+		{path: "typed[3]", ok: false},
+		{path: "array[1]", want: "y", ok: true},
+		{path: "struct.name", want: "cat", ok: true},
+		{path: "struct.price", want: 9.5, ok: true},
+		{path: "struct.Price", ok: false},
+		{path: "secret.User", want: "cat", ok: true},
+		{path: "secret.Password", ok: false},
+		{path: "struct.missing", ok: false},
+		{path: `dotted["x.y"]`, want: "z", ok: true},
+		{path: `dotted['p]']`, want: "q", ok: true},
+		{path: "nil", want: nil, ok: true},
+		{path: "nil.x", ok: false},
+		{path: "a.missing", ok: false},
+		{path: "a..b", ok: false},
+		{path: "a.b[x]", ok: false},
+		{path: "a.b[0", ok: false},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.path, func(t *testing.T) {
+			got, ok := into.Get(root, test.path)
+			if ok != test.ok {
+				t.Fatal("bad ok. want:", test.ok, "got:", ok)
+			}
+			if got != test.want {
+				t.Error("bad return value. want:", test.want, "got:", got)
+			}
+		})
+	}
+}
+
+func TestGetTyped(t *testing.T) {
+	t.Parallel()
+	root := map[string]any{
+		"user": map[string]any{"id": "42", "name": "cat", "score": 1.5, "age": uint8(3)},
+	}
+	if got := into.GetInt(root, "user.id", into.WithConvertStrings()); got != 42 {
+		t.Error("bad GetInt:", got)
+	}
+	if got := into.GetString(root, "user.name"); got != "cat" {
+		t.Error("bad GetString:", got)
+	}
+	if got := into.GetFloat(root, "user.score"); got != 1.5 {
+		t.Error("bad GetFloat:", got)
+	}
+	if got := into.GetUint(root, "user.age"); got != 3 {
+		t.Error("bad GetUint:", got)
+	}
+
+	err := into.Try(func() {
+		into.GetInt(root, "user.friends[0].id")
+	})
+	var pathErr into.ErrPath
+	if !errors.As(err, &pathErr) {
+		t.Fatal("expected ErrPath, got:", err)
+	}
+	if pathErr.Segment != "friends" {
+		t.Error("bad segment:", pathErr.Segment)
+	}
+	if !strings.Contains(err.Error(), `"friends"`) {
+		t.Error("error should mention the segment:", err)
+	}
+}
@@ -0,0 +1,291 @@
+package into
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Pointer resolves the JSON Pointer ptr (RFC 6901, such as "/items/0/price") inside of root.
+// It walks the same kinds of values as [Get]. The empty pointer refers to root itself.
+// If ptr cannot be resolved, Pointer returns ErrPath naming the token that failed.
+func Pointer(root any, ptr string) (any, error) {
+	tokens, err := parsePointer(ptr)
+	if err != nil {
+		return nil, ErrPath{Path: ptr, Cause: err}
+	}
+	cur := root
+	for _, tok := range tokens {
+		next, ok, err := pointerStep(cur, tok)
+		if !ok {
+			return nil, ErrPath{Path: ptr, Segment: tok, Value: cur, Cause: err}
+		}
+		cur = next
+	}
+	return cur, nil
+}
+
+// SetPointer sets the value referred to by the JSON Pointer ptr (RFC 6901) inside of root,
+// returning the possibly new root. Missing intermediate values are created as
+// map[string]any, or []any if the next token is an array index or "-".
+// The token "-" and the index one past the end of a slice append to it.
+// Values stored into typed maps, slices, or struct fields are coerced as by [Decode].
+// If ptr cannot be resolved, SetPointer returns ErrPath naming the token that failed.
+func SetPointer(root any, ptr string, value any) (any, error) {
+	tokens, err := parsePointer(ptr)
+	if err != nil {
+		return root, ErrPath{Path: ptr, Cause: err}
+	}
+	return setPointer(root, ptr, tokens, value)
+}
+
+func parsePointer(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+	if ptr[0] != '/' {
+		return nil, errors.New("JSON pointer must start with '/'")
+	}
+	tokens := strings.Split(ptr[1:], "/")
+	for i, tok := range tokens {
+		if !strings.Contains(tok, "~") {
+			continue
+		}
+		for j := 0; j < len(tok); j++ {
+			if tok[j] == '~' && (j+1 == len(tok) || (tok[j+1] != '0' && tok[j+1] != '1')) {
+				return nil, fmt.Errorf("bad escape in token %q", tok)
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tok, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// pointerIndex parses an array index as specified by RFC 6901: "0" or digits without a leading zero.
+func pointerIndex(tok string) (int, bool) {
+	if tok == "" || len(tok) > 1 && tok[0] == '0' {
+		return 0, false
+	}
+	n := 0
+	for _, c := range tok {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		d := int(c - '0')
+		if n > (math.MaxInt-d)/10 {
+			return 0, false
+		}
+		n = n*10 + d
+	}
+	return n, true
+}
+
+func isSliceLike(x any) bool {
+	rv := reflect.ValueOf(x)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return false
+		}
+		rv = rv.Elem()
+	}
+	return rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array
+}
+
+func pointerStep(x any, tok string) (any, bool, error) {
+	if isSliceLike(x) {
+		idx, ok := pointerIndex(tok)
+		if !ok {
+			return nil, false, nil
+		}
+		return step(x, segment{name: tok, index: idx})
+	}
+	return step(x, segment{name: tok, index: -1})
+}
+
+func setPointer(cur any, ptr string, tokens []string, value any) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	tok, rest := tokens[0], tokens[1:]
+	fail := func(cause error) (any, error) {
+		return cur, ErrPath{Path: ptr, Segment: tok, Value: cur, Cause: cause}
+	}
+	descend := func(child any) (any, error) {
+		return setPointer(child, ptr, rest, value)
+	}
+
+	switch x := cur.(type) {
+	case nil:
+		if _, ok := pointerIndex(tok); ok || tok == "-" {
+			if tok != "-" && tok != "0" {
+				return fail(errors.New("index out of range"))
+			}
+			child, err := descend(nil)
+			if err != nil {
+				return cur, err
+			}
+			return []any{child}, nil
+		}
+		child, err := descend(nil)
+		if err != nil {
+			return cur, err
+		}
+		return map[string]any{tok: child}, nil
+	case map[string]any:
+		child, err := descend(x[tok])
+		if err != nil {
+			return cur, err
+		}
+		x[tok] = child
+		return x, nil
+	case []any:
+		idx, ok := pointerIndex(tok)
+		if tok == "-" {
+			idx, ok = len(x), true
+		}
+		if !ok || idx > len(x) {
+			return fail(errors.New("index out of range"))
+		}
+		if idx == len(x) {
+			child, err := descend(nil)
+			if err != nil {
+				return cur, err
+			}
+			return append(x, child), nil
+		}
+		child, err := descend(x[idx])
+		if err != nil {
+			return cur, err
+		}
+		x[idx] = child
+		return x, nil
+	}
+
+	rv := reflect.ValueOf(cur)
+	for rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	target := rv
+	for target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			return fail(nil)
+		}
+		target = target.Elem()
+	}
+
+	// store assigns child into dst, coercing it as Decode would.
+	store := func(dst reflect.Value, child any) error {
+		d := decoder{}
+		d.value(dst, child, "")
+		return d.errs.err()
+	}
+
+	switch target.Kind() {
+	case reflect.Map:
+		key, err := mapKey(target.Type().Key(), tok)
+		if err != nil {
+			return fail(err)
+		}
+		var existing any
+		if v := target.MapIndex(key); v.IsValid() {
+			existing = v.Interface()
+		}
+		child, err := descend(existing)
+		if err != nil {
+			return cur, err
+		}
+		elem := reflect.New(target.Type().Elem()).Elem()
+		if err := store(elem, child); err != nil {
+			return fail(err)
+		}
+		if target.IsNil() {
+			if !target.CanSet() {
+				return fail(errors.New("nil map"))
+			}
+			target.Set(reflect.MakeMap(target.Type()))
+		}
+		target.SetMapIndex(key, elem)
+		return cur, nil
+	case reflect.Slice, reflect.Array:
+		idx, ok := pointerIndex(tok)
+		if tok == "-" {
+			idx, ok = target.Len(), true
+		}
+		if !ok || idx > target.Len() || (idx == target.Len() && target.Kind() == reflect.Array) {
+			return fail(errors.New("index out of range"))
+		}
+		if idx == target.Len() {
+			child, err := descend(nil)
+			if err != nil {
+				return cur, err
+			}
+			elem := reflect.New(target.Type().Elem()).Elem()
+			if err := store(elem, child); err != nil {
+				return fail(err)
+			}
+			grown := reflect.Append(target, elem)
+			if target.CanSet() {
+				target.Set(grown)
+				return cur, nil
+			}
+			return grown.Interface(), nil
+		}
+		ev := target.Index(idx)
+		existing := inPlace(ev)
+		child, err := descend(existing)
+		if err != nil {
+			return cur, err
+		}
+		if samePointer(child, existing) {
+			return cur, nil
+		}
+		if !ev.CanSet() {
+			return fail(errors.New("value is not addressable"))
+		}
+		if err := store(ev, child); err != nil {
+			return fail(err)
+		}
+		return cur, nil
+	case reflect.Struct:
+		for _, field := range planFor(target.Type()) {
+			if field.name != tok {
+				continue
+			}
+			if !target.CanSet() {
+				return fail(errors.New("struct is not addressable"))
+			}
+			fv := fieldByIndex(target, field.index)
+			existing := inPlace(fv)
+			child, err := descend(existing)
+			if err != nil {
+				return cur, err
+			}
+			if samePointer(child, existing) {
+				return cur, nil
+			}
+			if err := store(fv, child); err != nil {
+				return fail(err)
+			}
+			return cur, nil
+		}
+	}
+	return fail(nil)
+}
+
+// inPlace returns a pointer to v if it is an addressable composite value, so it can be modified in place.
+func inPlace(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
+		if v.CanAddr() {
+			return v.Addr().Interface()
+		}
+	}
+	return v.Interface()
+}
+
+func samePointer(a, b any) bool {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	return av.Kind() == reflect.Pointer && bv.Kind() == reflect.Pointer && av.Type() == bv.Type() && av.Pointer() == bv.Pointer()
+}
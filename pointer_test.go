@@ -0,0 +1,182 @@
+package into_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestPointer(t *testing.T) {
+	t.Parallel()
+	var root any
+	// example document from RFC 6901
+	err := json.Unmarshal([]byte(`{
+		"foo": ["bar", "baz"],
+		"": 0,
+		"a/b": 1,
+		"c%d": 2,
+		"e^f": 3,
+		"g|h": 4,
+		"i\\j": 5,
+		"k\"l": 6,
+		" ": 7,
+		"m~n": 8
+	}`), &root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		ptr  string
+		want any
+	}{
+		{ptr: "/foo", want: []any{"bar", "baz"}},
+		{ptr: "/foo/0", want: "bar"},
+		{ptr: "/", want: 0.0},
+		{ptr: "/a~1b", want: 1.0},
+		{ptr: "/c%d", want: 2.0},
+		{ptr: "/e^f", want: 3.0},
+		{ptr: "/g|h", want: 4.0},
+		{ptr: "/i\\j", want: 5.0},
+		{ptr: "/k\"l", want: 6.0},
+		{ptr: "/ ", want: 7.0},
+		{ptr: "/m~0n", want: 8.0},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.ptr, func(t *testing.T) {
+			got, err := into.Pointer(root, test.ptr)
+			if err != nil {
+				t.Fatal("unexpected error:", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Error("bad return value. want:", test.want, "got:", got)
+			}
+		})
+	}
+
+	got, err := into.Pointer(root, "")
+	if err != nil || !reflect.DeepEqual(got, root) {
+		t.Error("empty pointer should return root:", got, err)
+	}
+}
+
+func TestPointerErrors(t *testing.T) {
+	t.Parallel()
+	root := map[string]any{
+		"items": []any{map[string]any{"price": 1.5}},
+		"typed": &decodeItem{Name: "cat"},
+	}
+	if got, err := into.Pointer(root, "/typed/name"); err != nil || got != "cat" {
+		t.Error("bad struct lookup:", got, err)
+	}
+	for ptr, token := range map[string]string{
+		"/items/01/price":                   "01",
+		"/items/1/price":                    "1",
+		"/items/-":                          "-",
+		"/items/0/cost":                     "cost",
+		"/typed/missing":                    "missing",
+		"/items/18446744073709551616":       "18446744073709551616",
+		"/items/99999999999999999999999999": "99999999999999999999999999",
+	} {
+		_, err := into.Pointer(root, ptr)
+		var pathErr into.ErrPath
+		if !errors.As(err, &pathErr) {
+			t.Error(ptr, "expected ErrPath, got:", err)
+			continue
+		}
+		if pathErr.Segment != token {
+			t.Error(ptr, "bad token. want:", token, "got:", pathErr.Segment)
+		}
+	}
+	for _, ptr := range []string{"items", "/a~2b", "/a~"} {
+		if _, err := into.Pointer(root, ptr); err == nil {
+			t.Error(ptr, "expected error")
+		}
+	}
+}
+
+func TestSetPointerOverflow(t *testing.T) {
+	t.Parallel()
+	root := map[string]any{"items": []any{"zero", "one"}}
+	if _, err := into.SetPointer(root, "/items/18446744073709551616", "x"); err == nil {
+		t.Error("expected error for overflowing index")
+	}
+	if got := root["items"].([]any)[0]; got != "zero" {
+		t.Error("overflowing index modified element 0:", got)
+	}
+}
+
+func TestSetPointer(t *testing.T) {
+	t.Parallel()
+	root, err := into.SetPointer(nil, "/items/0/price", 1.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err = into.SetPointer(root, "/items/-", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err = into.SetPointer(root, "/a~1b", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"items": []any{map[string]any{"price": 1.5}, "x"},
+		"a/b":   true,
+	}
+	if !reflect.DeepEqual(root, want) {
+		t.Errorf("bad result.\nwant: %#v\n got: %#v", want, root)
+	}
+
+	if _, err := into.SetPointer(root, "/items/5", 1); err == nil {
+		t.Error("expected error for out of range index")
+	}
+}
+
+func TestSetPointerTyped(t *testing.T) {
+	t.Parallel()
+	order := &decodeOrder{Items: []decodeItem{{Name: "cat"}}}
+	if _, err := into.SetPointer(order, "/items/0/price", "9.5"); err == nil {
+		t.Error("expected error without string conversion")
+	}
+	if _, err := into.SetPointer(order, "/items/0/price", float32(9.5)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := into.SetPointer(order, "/items/-", map[string]any{"name": "dog"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := into.SetPointer(order, "/tags/a", "b"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := into.SetPointer(order, "/id", 7); err != nil {
+		t.Fatal(err)
+	}
+	want := &decodeOrder{
+		decodeBase: decodeBase{ID: 7},
+		Items:      []decodeItem{{Name: "cat", Price: 9.5}, {Name: "dog"}},
+		Tags:       map[string]string{"a": "b"},
+	}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("bad result.\nwant: %#v\n got: %#v", want, order)
+	}
+}
+
+func TestPointerHiddenField(t *testing.T) {
+	t.Parallel()
+	secret := &pathSecret{User: "cat", Password: "hunter2"}
+	if got, err := into.Pointer(secret, "/User"); err != nil || got != "cat" {
+		t.Error("unexpected result:", got, err)
+	}
+	if got, err := into.Pointer(secret, "/Password"); err == nil {
+		t.Error("expected error reading a field tagged into:\"-\", got:", got)
+	}
+	if _, err := into.SetPointer(secret, "/Password", "swordfish"); err == nil {
+		t.Error("expected error setting a field tagged into:\"-\"")
+	}
+	if secret.Password != "hunter2" {
+		t.Error("hidden field was modified:", secret.Password)
+	}
+}
@@ -0,0 +1,70 @@
+package into
+
+// CanScan returns true if the given value can be coerced to T by [Scan].
+// [Scan] will succeed without panicking if CanScan returns true.
+func CanScan[T any, PT interface {
+	*T
+	Scan(src any) error
+}](x any, options ...Option) bool {
+	_, err := scan[T, PT](x, options)
+	return err == nil
+}
+
+// Scan coerces x into T by scanning it, for any type T that implements sql.Scanner through its pointer,
+// such as sql.NullString or a custom ID type. It supports the following types:
+//   - T, *T
+//   - nil
+//   - anything else, which is passed to Scan as-is
+//
+// Scan will panic with ErrInvalid if Scan fails.
+func Scan[T any, PT interface {
+	*T
+	Scan(src any) error
+}](x any, options ...Option) T {
+	v, err := scan[T, PT](x, options)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ScanOK is like [Scan], but reports whether the conversion succeeded instead of panicking.
+func ScanOK[T any, PT interface {
+	*T
+	Scan(src any) error
+}](x any, options ...Option) (T, bool) {
+	v, err := scan[T, PT](x, options)
+	return v, err == nil
+}
+
+// ScanErr is like [Scan], but returns an error instead of panicking.
+func ScanErr[T any, PT interface {
+	*T
+	Scan(src any) error
+}](x any, options ...Option) (T, error) {
+	return scan[T, PT](x, options)
+}
+
+func scan[T any, PT interface {
+	*T
+	Scan(src any) error
+}](x any, options []Option) (T, error) {
+	switch x := x.(type) {
+	case T:
+		return x, nil
+	case *T:
+		if x == nil {
+			return fallbackFor[T](options, typeName[T]())
+		}
+		return *x, nil
+	case nil:
+		return fallbackFor[T](options, typeName[T]())
+	}
+
+	var v T
+	if err := PT(&v).Scan(x); err != nil {
+		var zero T
+		return zero, ErrInvalid{Value: x, Type: typeName[T](), Cause: err}
+	}
+	return v, nil
+}
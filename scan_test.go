@@ -0,0 +1,49 @@
+package into_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+	tests := table[sql.NullInt64]{
+		{
+			name:  "int64",
+			input: int64(42),
+			want:  sql.NullInt64{Int64: 42, Valid: true},
+		},
+		{
+			name:  "string",
+			input: "42",
+			want:  sql.NullInt64{Int64: 42, Valid: true},
+		},
+		{
+			name:  "same type",
+			input: sql.NullInt64{Int64: 1, Valid: true},
+			want:  sql.NullInt64{Int64: 1, Valid: true},
+		},
+		{
+			name:  "nil",
+			input: nil,
+			want:  sql.NullInt64{},
+		},
+		{
+			name:  "invalid",
+			input: "cat",
+			err:   errors.New("scan error"),
+		},
+	}
+	tests.Run(t, into.Scan[sql.NullInt64])
+	tests.RunErr(t, into.ScanErr[sql.NullInt64])
+
+	if got := into.Scan[scanID]("id-7"); got != 7 {
+		t.Error("bad custom scanner:", got)
+	}
+	if into.CanScan[scanID](1.5) {
+		t.Error("succeeded but should have failed")
+	}
+}
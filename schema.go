@@ -0,0 +1,417 @@
+package into
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"unicode/utf8"
+)
+
+// Schema describes the expected shape of a dynamic value, such as a decoded JSON document.
+// Schemas are built from [Object], [Field] constructors such as [IntField], and [SliceOf].
+type Schema interface {
+	// coerce returns x coerced to the schema, recording any errors relative to path.
+	coerce(x any, path string, options []Option, errs *Errors) any
+}
+
+// Object is a [Schema] for maps with string keys, such as map[string]any, given the schema of each key.
+// Keys are optional unless their schema is [Field.Required]. Keys not in the Object are kept as-is.
+//
+//	schema := into.Object{
+//		"id":   into.IntField().Required(),
+//		"tags": into.SliceOf(into.StringField()),
+//	}
+type Object map[string]Schema
+
+// Validate reports whether doc matches the schema, returning [Errors] listing every invalid field if not.
+// Options such as [WithConvertStrings] are given to the coercers.
+func (o Object) Validate(doc any, options ...Option) error {
+	_, err := o.Coerce(doc, options...)
+	return err
+}
+
+// Coerce returns a copy of doc with each field coerced by its schema:
+// for example, given [IntField], a float64 of 42 from encoding/json becomes an int.
+// Options such as [WithConvertStrings] are given to the coercers.
+//
+// If any field is invalid, Coerce returns nil and [Errors] listing every invalid field.
+func (o Object) Coerce(doc any, options ...Option) (map[string]any, error) {
+	out, err := ObjectField(o).Required().Coerce(doc, options...)
+	if err != nil {
+		return nil, err
+	}
+	return out.(map[string]any), nil
+}
+
+func (o Object) coerce(x any, path string, options []Option, errs *Errors) any {
+	return ObjectField(o).coerce(x, path, options, errs)
+}
+
+// Field is a [Schema] for a single value, with optional constraints.
+// Use a constructor such as [IntField] to create one.
+// Fields are immutable: each method returns a modified copy.
+type Field struct {
+	typ      fieldType
+	required bool
+	nullable bool
+	nonNull  bool // rejects nil even if not required, as typed JSON Schema fields do
+	min, max *float64
+	minLen   *int
+	maxLen   *int
+	enum     []any
+	pattern  *regexp.Regexp
+	elem     Schema
+	fields   Object
+}
+
+type fieldType int
+
+const (
+	fieldAny fieldType = iota
+	fieldInt
+	fieldUint
+	fieldFloat
+	fieldString
+	fieldBool
+	fieldSlice
+	fieldObject
+	fieldNull
+)
+
+func (typ fieldType) String() string {
+	switch typ {
+	case fieldInt:
+		return "int"
+	case fieldUint:
+		return "uint"
+	case fieldFloat:
+		return "float"
+	case fieldString:
+		return "string"
+	case fieldBool:
+		return "bool"
+	case fieldSlice:
+		return "slice"
+	case fieldObject:
+		return "object"
+	case fieldNull:
+		return "null"
+	}
+	return "value"
+}
+
+// AnyField returns a [Field] that accepts any value as-is.
+func AnyField() Field { return Field{typ: fieldAny} }
+
+// nullField returns a [Field] that only accepts nil, for the JSON Schema "null" type.
+func nullField() Field { return Field{typ: fieldNull, nullable: true} }
+
+// IntField returns a [Field] for int values.
+// Like [Set], it accepts any number that fits without losing precision, such as a float64 from encoding/json,
+// and given [WithConvertStrings], numeric strings.
+func IntField() Field { return Field{typ: fieldInt} }
+
+// UintField returns a [Field] for uint values, with the same rules as [IntField].
+func UintField() Field { return Field{typ: fieldUint} }
+
+// FloatField returns a [Field] for float64 values, with the same rules as [IntField].
+func FloatField() Field { return Field{typ: fieldFloat} }
+
+// StringField returns a [Field] that coerces values with [String].
+func StringField() Field { return Field{typ: fieldString} }
+
+// BoolField returns a [Field] that coerces values with [Bool].
+func BoolField() Field { return Field{typ: fieldBool} }
+
+// SliceOf returns a [Field] for slices or arrays, coercing each element with elem into a []any.
+func SliceOf(elem Schema) Field { return Field{typ: fieldSlice, elem: elem} }
+
+// ObjectField returns a [Field] for nested objects, so that they can be given constraints such as [Field.Required].
+func ObjectField(o Object) Field { return Field{typ: fieldObject, fields: o} }
+
+// Required returns a copy of f that rejects missing and nil values with [ErrRequired].
+func (f Field) Required() Field {
+	f.required = true
+	return f
+}
+
+// Min returns a copy of f that rejects numbers less than n with [ErrRange].
+func (f Field) Min(n float64) Field {
+	f.min = &n
+	return f
+}
+
+// Max returns a copy of f that rejects numbers greater than n with [ErrRange].
+func (f Field) Max(n float64) Field {
+	f.max = &n
+	return f
+}
+
+// MinLen returns a copy of f that rejects strings with fewer than n characters, or slices with fewer than n elements,
+// with [ErrRange].
+func (f Field) MinLen(n int) Field {
+	f.minLen = &n
+	return f
+}
+
+// MaxLen returns a copy of f that rejects strings with more than n characters, or slices with more than n elements,
+// with [ErrRange].
+func (f Field) MaxLen(n int) Field {
+	f.maxLen = &n
+	return f
+}
+
+// OneOf returns a copy of f that rejects values not equal to one of values, as defined by [Equal], with [ErrRange].
+func (f Field) OneOf(values ...any) Field {
+	f.enum = slices.Clone(values)
+	return f
+}
+
+// Pattern returns a copy of f that rejects strings not matching re with [ErrSyntax].
+// As in JSON Schema, the pattern is not anchored: use ^ and $ to match the whole string.
+func (f Field) Pattern(re *regexp.Regexp) Field {
+	f.pattern = re
+	return f
+}
+
+// Validate reports whether x matches the schema, returning [Errors] listing every invalid value if not.
+// Options such as [WithConvertStrings] are given to the coercers.
+func (f Field) Validate(x any, options ...Option) error {
+	_, err := f.Coerce(x, options...)
+	return err
+}
+
+// Coerce returns x coerced by the schema, such as a []any for [SliceOf] or a map[string]any for [ObjectField].
+// Options such as [WithConvertStrings] are given to the coercers.
+//
+// If x is invalid, Coerce returns nil and [Errors] listing every invalid value.
+func (f Field) Coerce(x any, options ...Option) (any, error) {
+	var errs Errors
+	out := f.coerce(x, "", withoutFallback(options), &errs)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return out, nil
+}
+
+func (f Field) coerce(x any, path string, options []Option, errs *Errors) any {
+	fail := func(err error) any {
+		invalid, ok := err.(ErrInvalid)
+		if !ok {
+			invalid = ErrInvalid{Value: x, Type: f.typ.String(), Cause: err}
+		}
+		invalid.Path = joinPaths(path, invalid.Path)
+		*errs = append(*errs, invalid)
+		return nil
+	}
+
+	if _, ok := indirect(reflect.ValueOf(x)); !ok {
+		switch {
+		case f.nullable:
+		case f.required:
+			return fail(ErrRequired)
+		case f.nonNull:
+			return fail(nil)
+		}
+		return nil
+	}
+
+	var v any
+	var err error
+	switch f.typ {
+	case fieldInt:
+		v, err = setField[int](x, options)
+	case fieldUint:
+		v, err = setField[uint](x, options)
+	case fieldFloat:
+		v, err = setField[float64](x, options)
+	case fieldString:
+		v, err = toString(x, options)
+	case fieldBool:
+		v, err = toBool(x, options)
+	case fieldSlice:
+		n := len(*errs)
+		v = f.slice(x, path, options, errs)
+		if v == nil && len(*errs) == n {
+			return fail(nil)
+		}
+	case fieldObject:
+		n := len(*errs)
+		v = f.object(x, path, options, errs)
+		if v == nil && len(*errs) == n {
+			return fail(nil)
+		}
+	case fieldNull:
+		return fail(nil)
+	default:
+		v = x
+	}
+	if err != nil {
+		return fail(err)
+	}
+	if err := f.check(v); err != nil {
+		return fail(err)
+	}
+	return v
+}
+
+// setField coerces x into a T with [Set], so that numbers convert between kinds without losing precision.
+func setField[T any](x any, options []Option) (any, error) {
+	var v T
+	err := Set(&v, x, options...)
+	return v, err
+}
+
+// check applies f's constraints to the coerced value v.
+// As in JSON Schema, each constraint only applies to the kinds of values it governs:
+// Min and Max to numbers, MinLen and MaxLen to strings and slices, and Pattern to strings.
+func (f Field) check(v any) error {
+	switch KindOf(v) {
+	case KindInt, KindUint, KindFloat:
+		if f.min != nil {
+			if c, _ := Compare(v, *f.min); c < 0 {
+				return fmt.Errorf("%w: %v is less than the minimum %v", ErrRange, v, *f.min)
+			}
+		}
+		if f.max != nil {
+			if c, _ := Compare(v, *f.max); c > 0 {
+				return fmt.Errorf("%w: %v is greater than the maximum %v", ErrRange, v, *f.max)
+			}
+		}
+	}
+	n := -1
+	switch v := v.(type) {
+	case string:
+		n = utf8.RuneCountInString(v)
+	case []any:
+		n = len(v)
+	}
+	if n >= 0 {
+		if f.minLen != nil && n < *f.minLen {
+			return fmt.Errorf("%w: length %d is less than the minimum %d", ErrRange, n, *f.minLen)
+		}
+		if f.maxLen != nil && n > *f.maxLen {
+			return fmt.Errorf("%w: length %d is greater than the maximum %d", ErrRange, n, *f.maxLen)
+		}
+	}
+	if str, ok := v.(string); ok && f.pattern != nil && !f.pattern.MatchString(str) {
+		return fmt.Errorf("%w: %q does not match the pattern %s", ErrSyntax, str, f.pattern)
+	}
+	if f.enum != nil && !slices.ContainsFunc(f.enum, func(member any) bool { return Equal(v, member) }) {
+		return fmt.Errorf("%w: %v is not one of %v", ErrRange, v, f.enum)
+	}
+	return nil
+}
+
+// slice coerces each element of the slice or array x, returning nil if x is not one.
+func (f Field) slice(x any, path string, options []Option, errs *Errors) any {
+	rv, _ := indirect(reflect.ValueOf(x))
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil
+	}
+	out := make([]any, rv.Len())
+	for i := range out {
+		elem := rv.Index(i).Interface()
+		if f.elem != nil {
+			elem = f.elem.coerce(elem, joinPaths(path, pathKey(i)), options, errs)
+		}
+		out[i] = elem
+	}
+	return out
+}
+
+// object coerces each field of the map x, returning nil if x is not a map with string keys.
+func (f Field) object(x any, path string, options []Option, errs *Errors) any {
+	rv, _ := indirect(reflect.ValueOf(x))
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil
+	}
+	out := make(map[string]any, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		out[iter.Key().String()] = iter.Value().Interface()
+	}
+
+	keys := make([]string, 0, len(f.fields))
+	for key := range f.fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		schema := f.fields[key]
+		at := joinPaths(path, pathKey(key))
+		v, ok := out[key]
+		if !ok {
+			if field, ok := schema.(Field); ok && field.required {
+				*errs = append(*errs, ErrInvalid{Type: field.typ.String(), Path: at, Cause: ErrRequired})
+			}
+			continue
+		}
+		out[key] = schema.coerce(v, at, options, errs)
+	}
+	return out
+}
+
+// SchemaOf returns a schema for the type of x, which is typically the zero value of a struct.
+// Struct fields are named and promoted as in [Decode], using `into` or `json` tags,
+// and are required unless they are pointers or tagged omitempty.
+// Types implementing [encoding.TextUnmarshaler], such as time.Time, are strings.
+// []byte is a string, maps with string keys are objects, and interfaces accept any value.
+func SchemaOf(x any) Field {
+	return schemaFor(reflect.TypeOf(x), make(map[reflect.Type]bool))
+}
+
+func schemaFor(rt reflect.Type, visited map[reflect.Type]bool) Field {
+	if rt == nil {
+		return AnyField()
+	}
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if reflect.PointerTo(rt).Implements(textUnmarshalerType) {
+		return StringField()
+	}
+	switch rt.Kind() {
+	case reflect.Int, reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8:
+		return IntField()
+	case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8, reflect.Uintptr:
+		return UintField()
+	case reflect.Float64, reflect.Float32:
+		return FloatField()
+	case reflect.String:
+		return StringField()
+	case reflect.Bool:
+		return BoolField()
+	case reflect.Slice:
+		if rt.Elem().Kind() == reflect.Uint8 {
+			return StringField()
+		}
+		return SliceOf(schemaFor(rt.Elem(), visited))
+	case reflect.Array:
+		return SliceOf(schemaFor(rt.Elem(), visited)).MinLen(rt.Len()).MaxLen(rt.Len())
+	case reflect.Map:
+		if rt.Key().Kind() == reflect.String {
+			return ObjectField(Object{})
+		}
+	case reflect.Struct:
+		if visited[rt] {
+			// recursive types are left open
+			return ObjectField(Object{})
+		}
+		visited[rt] = true
+		defer delete(visited, rt)
+		o := make(Object)
+		for _, plan := range planFor(rt) {
+			ft := rt.FieldByIndex(plan.index).Type
+			field := schemaFor(ft, visited)
+			if !plan.omitEmpty && ft.Kind() != reflect.Pointer {
+				field = field.Required()
+			}
+			o[plan.name] = field
+		}
+		return ObjectField(o)
+	}
+	return AnyField()
+}
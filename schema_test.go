@@ -0,0 +1,180 @@
+package into_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+var webhookSchema = into.Object{
+	"id":     into.IntField().Required().Min(1),
+	"name":   into.StringField().MinLen(1).MaxLen(8),
+	"status": into.StringField().OneOf("open", "closed"),
+	"score":  into.FloatField().Min(0).Max(1),
+	"tags":   into.SliceOf(into.StringField().Required()).MaxLen(3),
+	"user": into.ObjectField(into.Object{
+		"admin": into.BoolField(),
+	}).Required(),
+}
+
+func TestSchemaCoerce(t *testing.T) {
+	t.Parallel()
+	var doc map[string]any
+	err := json.Unmarshal([]byte(`{
+		"id": 42,
+		"name": "héllo",
+		"status": "open",
+		"score": 0.5,
+		"tags": ["a", "b"],
+		"user": {"admin": true},
+		"extra": null
+	}`), &doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := webhookSchema.Coerce(doc)
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	want := map[string]any{
+		"id":     42,
+		"name":   "héllo",
+		"status": "open",
+		"score":  0.5,
+		"tags":   []any{"a", "b"},
+		"user":   map[string]any{"admin": true},
+		"extra":  nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bad result. want: %#v got: %#v", want, got)
+	}
+}
+
+func TestSchemaConvertStrings(t *testing.T) {
+	t.Parallel()
+	doc := map[string]any{"id": "42", "user": map[string]string{"admin": "true"}}
+	got, err := webhookSchema.Coerce(doc, into.WithConvertStrings())
+	if err != nil {
+		t.Fatal("unexpected error:", err)
+	}
+	want := map[string]any{"id": 42, "user": map[string]any{"admin": true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bad result. want: %#v got: %#v", want, got)
+	}
+	if err := webhookSchema.Validate(doc); err == nil {
+		t.Error("expected error without WithConvertStrings")
+	}
+}
+
+func TestSchemaErrors(t *testing.T) {
+	t.Parallel()
+	doc := map[string]any{
+		"id":     0,
+		"name":   "much too long",
+		"status": "pending",
+		"score":  1.5,
+		"tags":   []any{"a", nil, 3, "d"},
+		"user":   map[string]any{"admin": "yes"},
+	}
+	got, err := webhookSchema.Coerce(doc)
+	if got != nil {
+		t.Error("expected nil result, got:", got)
+	}
+	var errs into.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("want Errors, got: %T %v", err, err)
+	}
+	want := map[string]error{
+		"id":         into.ErrRange,
+		"name":       into.ErrRange,
+		"score":      into.ErrRange,
+		"status":     into.ErrRange,
+		"tags":       into.ErrRange,
+		"tags[1]":    into.ErrRequired,
+		"tags[2]":    into.ErrUnsupportedType,
+		"user.admin": into.ErrUnsupportedType,
+	}
+	if len(errs) != len(want) {
+		t.Errorf("want %d errors, got %d: %v", len(want), len(errs), err)
+	}
+	for _, err := range errs {
+		kind, ok := want[err.Path]
+		if !ok {
+			t.Error("unexpected error:", err)
+			continue
+		}
+		if !errors.Is(err, kind) {
+			t.Errorf("at %s: want %v, got: %v", err.Path, kind, err)
+		}
+	}
+}
+
+func TestSchemaRequired(t *testing.T) {
+	t.Parallel()
+	err := webhookSchema.Validate(map[string]any{"id": nil})
+	var errs into.Errors
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatal("want 2 errors, got:", err)
+	}
+	for i, path := range []string{"id", "user"} {
+		if errs[i].Path != path || !errors.Is(errs[i], into.ErrRequired) {
+			t.Errorf("want ErrRequired at %s, got: %v", path, errs[i])
+		}
+	}
+
+	if err := webhookSchema.Validate(nil); !errors.Is(err, into.ErrRequired) {
+		t.Error("want ErrRequired for nil document, got:", err)
+	}
+	if err := webhookSchema.Validate([]int{1}); !errors.Is(err, into.ErrUnsupportedType) {
+		t.Error("want ErrUnsupportedType for non-object document, got:", err)
+	}
+	if err := webhookSchema.Validate(map[string]any{"id": 1, "user": map[string]any{}, "tags": []any{"a", "b", "c", "d"}}); into.ErrorPath(err) != "tags" {
+		t.Error("want error at tags, got:", err)
+	}
+}
+
+func TestSchemaConstraintKinds(t *testing.T) {
+	t.Parallel()
+	table := []struct {
+		name   string
+		schema into.Field
+		input  any
+		valid  bool
+	}{
+		{name: "min on string", schema: into.AnyField().Min(1), input: "abc", valid: true},
+		{name: "max on bool", schema: into.AnyField().Max(0), input: true, valid: true},
+		{name: "min on number", schema: into.AnyField().Min(1), input: 0, valid: false},
+		{name: "minLen on number", schema: into.AnyField().MinLen(1), input: 5, valid: true},
+		{name: "maxLen on object", schema: into.AnyField().MaxLen(0), input: map[string]any{"a": 1}, valid: true},
+		{name: "minLen on string", schema: into.AnyField().MinLen(1), input: "", valid: false},
+		{name: "pattern on number", schema: into.AnyField().Pattern(regexp.MustCompile(`^a`)), input: 5, valid: true},
+	}
+	for _, test := range table {
+		err := test.schema.Validate(test.input)
+		if test.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+
+	schema, err := into.ParseJSONSchema([]byte(`{"type": "string", "minimum": 1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := schema.Validate("abc"); err != nil {
+		t.Error("minimum should not apply to strings:", err)
+	}
+	schema, err = into.ParseJSONSchema([]byte(`{"minLength": 1}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := schema.Validate(5); err != nil {
+		t.Error("minLength should not apply to numbers:", err)
+	}
+}
@@ -0,0 +1,32 @@
+package into
+
+import (
+	"reflect"
+)
+
+// Set coerces src and stores the result in dst, which must be a non-nil pointer.
+// The coercer is chosen by the underlying kind of dst's element type, with the same rules as [Decode]:
+// for example, a *int16 or *time.Duration destination accepts any number that fits without losing precision,
+// and given [WithConvertStrings], numeric strings (or duration strings for time.Duration).
+// Types implementing [encoding.TextUnmarshaler] or sql.Scanner through dst are given src to parse.
+//
+// If src is nil or a nil pointer, dst is set to the value given by [WithFallback], coerced to dst's element type,
+// or the zero value.
+//
+// Set returns an ErrInvalid if src cannot be coerced, or [Errors] if a struct or container has several invalid parts.
+// On failure, dst may be partially modified.
+func Set(dst any, src any, options ...Option) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return ErrInvalid{Value: dst, Type: "non-nil pointer"}
+	}
+	if sv := reflect.ValueOf(src); src == nil || (sv.Kind() == reflect.Pointer && sv.IsNil()) {
+		src, _ = fallbackFor[any](options, "")
+	}
+	d := decoder{options: withoutFallback(options)}
+	d.value(rv.Elem(), src, "")
+	if len(d.errs) == 1 {
+		return d.errs[0]
+	}
+	return d.errs.err()
+}
@@ -0,0 +1,97 @@
+package into_test
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/guregu/into"
+)
+
+type scanID int
+
+func (id *scanID) Scan(src any) error {
+	switch src := src.(type) {
+	case int64:
+		*id = scanID(src)
+	case string:
+		var n int
+		if _, err := fmt.Sscanf(src, "id-%d", &n); err != nil {
+			return err
+		}
+		*id = scanID(n)
+	default:
+		return fmt.Errorf("bad id: %v", src)
+	}
+	return nil
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	var i16 int16
+	if err := into.Set(&i16, 42.0); err != nil || i16 != 42 {
+		t.Error("bad int16:", i16, err)
+	}
+	if err := into.Set(&i16, 1<<20); !errors.Is(err, into.ErrRange) {
+		t.Error("expected ErrRange, got:", err)
+	}
+
+	var dur time.Duration
+	if err := into.Set(&dur, "1m30s", into.WithConvertStrings()); err != nil || dur != 90*time.Second {
+		t.Error("bad duration:", dur, err)
+	}
+	if err := into.Set(&dur, int64(time.Second)); err != nil || dur != time.Second {
+		t.Error("bad duration:", dur, err)
+	}
+	if err := into.Set(&dur, "soon", into.WithConvertStrings()); !errors.Is(err, into.ErrSyntax) {
+		t.Error("expected ErrSyntax, got:", err)
+	}
+
+	var named myString
+	if err := into.Set(&named, []byte("hello")); err != nil || named != "hello" {
+		t.Error("bad string:", named, err)
+	}
+
+	var addr netip.Addr
+	if err := into.Set(&addr, myString("192.0.2.1")); err != nil || addr != netip.MustParseAddr("192.0.2.1") {
+		t.Error("bad TextUnmarshaler:", addr, err)
+	}
+
+	var id scanID
+	if err := into.Set(&id, "id-7"); err != nil || id != 7 {
+		t.Error("bad Scanner:", id, err)
+	}
+	if err := into.Set(&id, 1.5); err == nil {
+		t.Error("expected Scanner error")
+	}
+
+	var ptr *int
+	if err := into.Set(&ptr, "12", into.WithConvertStrings()); err != nil || ptr == nil || *ptr != 12 {
+		t.Error("bad pointer:", ptr, err)
+	}
+
+	var ei into.ErrInvalid
+	if err := into.Set(&i16, "x"); !errors.As(err, &ei) || ei.Type != "int16" {
+		t.Error("unexpected error:", err)
+	}
+	if err := into.Set(i16, 1); err == nil {
+		t.Error("expected error for non-pointer destination")
+	}
+}
+
+func TestSetFallback(t *testing.T) {
+	t.Parallel()
+	n := int8(1)
+	if err := into.Set(&n, nil); err != nil || n != 0 {
+		t.Error("nil should set the zero value:", n, err)
+	}
+	if err := into.Set(&n, (*int)(nil), into.WithFallback(5)); err != nil || n != 5 {
+		t.Error("bad fallback:", n, err)
+	}
+	if err := into.Set(&n, nil, into.WithFallback(500)); !errors.Is(err, into.ErrRange) {
+		t.Error("expected ErrRange for fallback, got:", err)
+	}
+}
@@ -0,0 +1,89 @@
+package into
+
+import "reflect"
+
+// CanSlice returns true if the given value can be coerced to a slice using conv for each element.
+// [Slice] will succeed without panicking if CanSlice returns true.
+//
+// See: [Slice] for supported types.
+func CanSlice[T any](x any, conv func(any, ...Option) T, options ...Option) bool {
+	_, err := slice(x, conv, options)
+	return err == nil
+}
+
+// Slice coerces x into a slice, converting each element with conv (such as [Int] or [String]).
+// Options are passed along to conv. Slice supports the following types:
+//   - []any
+//   - any slice or array type, or pointers to such types, unless [WithoutReflection] is used
+//   - given [WithScalarAsSlice], any other value as a single-element slice
+//   - nil, returning a nil slice
+//
+// Slice will panic with ErrInvalid if the value cannot be coerced.
+// If an element fails to convert, its error's Path reports the element's index.
+func Slice[T any](x any, conv func(any, ...Option) T, options ...Option) []T {
+	s, err := slice(x, conv, options)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func slice[T any](x any, conv func(any, ...Option) T, options []Option) (out []T, err error) {
+	idx := -1
+	defer func() {
+		if err != nil && idx >= 0 {
+			err = atPath(err, idx, x, sliceTypeName[T]())
+		}
+	}()
+	defer catch(&err)
+
+	switch v := x.(type) {
+	case []any:
+		if v == nil {
+			return nil, nil
+		}
+		out = make([]T, len(v))
+		for i, elem := range v {
+			idx = i
+			out[i] = conv(elem, options...)
+		}
+		return out, nil
+	case nil:
+		return nil, nil
+	}
+
+	if !should(options, skipReflect) {
+		rv := reflect.ValueOf(x)
+		for rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return nil, nil
+			}
+			rv = rv.Elem()
+		}
+		switch rv.Kind() {
+		case reflect.Slice:
+			if rv.IsNil() {
+				return nil, nil
+			}
+			fallthrough
+		case reflect.Array:
+			out = make([]T, rv.Len())
+			for i := range out {
+				idx = i
+				out[i] = conv(rv.Index(i).Interface(), options...)
+			}
+			return out, nil
+		}
+	}
+
+	if should(options, scalarAsSlice) {
+		idx = 0
+		return []T{conv(x, options...)}, nil
+	}
+
+	return nil, ErrInvalid{Value: x, Type: sliceTypeName[T]()}
+}
+
+func sliceTypeName[T any]() string {
+	return "[]" + reflect.TypeOf((*T)(nil)).Elem().String()
+}
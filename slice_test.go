@@ -0,0 +1,128 @@
+package into_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestSlice(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		input any
+		want  []int
+		opts  []into.Option
+		err   bool
+	}{
+		{
+			name:  "[]any",
+			input: []any{1, int64(2), myInt(3)},
+			want:  []int{1, 2, 3},
+		},
+		{
+			name:  "[]int64",
+			input: []int64{1, 2, 3},
+			want:  []int{1, 2, 3},
+		},
+		{
+			name:  "array",
+			input: [3]int8{1, 2, 3},
+			want:  []int{1, 2, 3},
+		},
+		{
+			name:  "pointer to slice",
+			input: &[]int{1, 2},
+			want:  []int{1, 2},
+		},
+		{
+			name:  "[]string with conversion",
+			input: []string{"1", "2"},
+			want:  []int{1, 2},
+			opts:  []into.Option{into.WithConvertStrings()},
+		},
+		{
+			name:  "empty",
+			input: []any{},
+			want:  []int{},
+		},
+		{
+			name:  "nil",
+			input: nil,
+			want:  nil,
+		},
+		{
+			name:  "nil slice",
+			input: []int(nil),
+			want:  nil,
+		},
+		{
+			name:  "scalar",
+			input: 42,
+			err:   true,
+		},
+		{
+			name:  "scalar as slice",
+			input: 42,
+			want:  []int{42},
+			opts:  []into.Option{into.WithScalarAsSlice()},
+		},
+		{
+			name:  "bad element",
+			input: []any{1, "x"},
+			err:   true,
+		},
+		{
+			name:  "typed slice without reflection",
+			input: []int{1},
+			opts:  []into.Option{into.WithoutReflection()},
+			err:   true,
+		},
+		{
+			name:  "element fallback",
+			input: []any{1, nil},
+			want:  []int{1, 42},
+			opts:  []into.Option{into.WithFallback(42)},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := into.Maybe(func(x any, options ...into.Option) []int {
+				return into.Slice(x, into.Int, options...)
+			}, test.input, test.opts...)
+			if (err != nil) != test.err {
+				t.Fatal("unexpected error state:", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Error("bad return value. want:", test.want, "got:", got)
+			}
+		})
+	}
+}
+
+func TestSliceElementError(t *testing.T) {
+	t.Parallel()
+	err := into.Try(func() {
+		into.Slice([]any{"a", 1.5}, into.String)
+	})
+	var ei into.ErrInvalid
+	if !errors.As(err, &ei) || ei.Type != "string" || ei.Value != 1.5 {
+		t.Fatal("unexpected error:", err)
+	}
+	if ei.Path != "[1]" {
+		t.Error("error should report the index. got path:", ei.Path)
+	}
+}
+
+func TestCanSlice(t *testing.T) {
+	t.Parallel()
+	if !into.CanSlice([]any{1.5, float32(2)}, into.Float) {
+		t.Error("failed but should have succeeded")
+	}
+	if into.CanSlice([]any{"a"}, into.Float) {
+		t.Error("succeeded but should have failed")
+	}
+}
@@ -52,80 +52,139 @@ func CanString(x any, options ...Option) bool {
 //
 // String will panic with ErrInvalid if the value cannot be coerced or TextMarshaler fails.
 func String(x any, options ...Option) string {
+	v, err := toString(x, options)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// StringOK is like [String], but reports whether the conversion succeeded instead of panicking.
+func StringOK(x any, options ...Option) (string, bool) {
+	v, err := toString(x, options)
+	return v, err == nil
+}
+
+// StringErr is like [String], but returns an error instead of panicking.
+func StringErr(x any, options ...Option) (string, error) {
+	return toString(x, options)
+}
+
+func toString(x any, options []Option) (string, error) {
 	switch x := x.(type) {
 	case string:
-		return x
+		return x, nil
 	case []byte:
-		return string(x)
+		return string(x), nil
 	case rune:
-		return string(x)
+		return string(x), nil
 	case []rune:
-		return string(x)
+		return string(x), nil
 	case *string:
 		if x == nil {
 			goto fallback
 		}
-		return *x
+		return *x, nil
 	case *rune:
 		if x == nil {
 			goto fallback
 		}
-		return string(*x)
+		return string(*x), nil
 	case encoding.TextMarshaler:
 		bs, err := x.MarshalText()
 		if err != nil {
-			panic(ErrInvalid{Value: x, Type: "string", Cause: err})
+			return "", ErrInvalid{Value: x, Type: "string", Cause: err}
 		}
-		return string(bs)
+		return string(bs), nil
 	case fmt.Stringer:
-		return x.String()
+		return x.String(), nil
 	case nil:
 		goto fallback
 	}
 
 	if !should(options, skipReflect) {
-		rv := reflect.ValueOf(x)
-		for rv.Kind() == reflect.Pointer {
-			if rv.IsNil() {
-				goto fallback
-			}
-			rv = rv.Elem()
+		str, ok, err := stringConverters.convert(x)
+		if err == errNil {
+			goto fallback
 		}
-		switch rv.Kind() {
-		case reflect.String:
-			return rv.String()
-		case reflect.Slice:
-			switch rv.Type().Elem().Kind() {
-			case reflect.Uint8: // []byte
-				if rv.IsNil() {
-					goto fallback
-				}
-				return string(rv.Bytes())
-			case reflect.Int32: // []rune
-				if rv.IsNil() {
-					goto fallback
-				}
-				return string(rv.Convert(runesType).Interface().([]rune))
-			default:
-				panic(ErrInvalid{Value: x, Type: "string"})
-			}
+		if ok {
+			return str, nil
 		}
 	}
 
-	panic(ErrInvalid{Value: x, Type: "string"})
+	return "", ErrInvalid{Value: x, Type: "string"}
 
 fallback:
-	var null string
-	for _, opt := range options {
-		if opt, ok := opt.(fallbackValue); ok {
-			null, ok = opt.x.(string)
-			if !ok {
-				panic(fmt.Errorf("invalid fallback value: %v (type of %T), must be %s", opt, opt, "string"))
+	return fallbackFor[string](options, "string")
+}
+
+// StringValue is like [String], but takes a reflect.Value such as a struct field.
+// Values with an underlying string-like type are converted directly, without boxing them into an interface,
+// including unexported struct fields.
+func StringValue(rv reflect.Value, options ...Option) string {
+	str, err := stringValue(rv, options)
+	if err != nil {
+		panic(err)
+	}
+	return str
+}
+
+func stringValue(rv reflect.Value, options []Option) (string, error) {
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	rv, ok := indirect(rv)
+	if !ok {
+		return fallbackFor[string](options, "string")
+	}
+	if str, ok, err := stringKind(rv); ok {
+		if err == errNil {
+			return fallbackFor[string](options, "string")
+		}
+		return str, nil
+	}
+	return fromValue(rv, "string", options, toString)
+}
+
+var stringConverters = newConverterCache(stringKind)
+
+// stringKind converts rv if it has an underlying string, []byte, or []rune type, reporting false otherwise.
+// It returns errNil for nil slices.
+func stringKind(rv reflect.Value) (string, bool, error) {
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), true, nil
+	case reflect.Slice:
+		switch rv.Type().Elem().Kind() {
+		case reflect.Uint8: // []byte
+			if rv.IsNil() {
+				return "", true, errNil
+			}
+			return string(rv.Bytes()), true, nil
+		case reflect.Int32: // []rune
+			if rv.IsNil() {
+				return "", true, errNil
+			}
+			if rv.CanInterface() {
+				return string(rv.Convert(runesType).Interface().([]rune)), true, nil
 			}
-			break
+			runes := make([]rune, rv.Len())
+			for i := range runes {
+				runes[i] = rune(rv.Index(i).Int())
+			}
+			return string(runes), true, nil
 		}
 	}
-	return null
+	return "", false, nil
 }
 
 var runesType = reflect.TypeOf([]rune{})
+
+// stringFor converts a string-like x for parsing by the other coercers.
+// Only [WithoutReflection] is passed along, as options such as the fallback value belong to the caller.
+func stringFor(x any, options []Option) (string, error) {
+	if should(options, skipReflect) {
+		return toString(x, []Option{skipReflect})
+	}
+	return toString(x, nil)
+}
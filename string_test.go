@@ -169,6 +169,7 @@ func TestString(t *testing.T) {
 		},
 	}
 	tests.Run(t, into.String)
+	tests.RunErr(t, into.StringErr)
 }
 
 func TestStringInvalidFallback(t *testing.T) {
@@ -281,6 +282,28 @@ func BenchmarkStringWithOptions(b *testing.B) {
 	}
 }
 
+func BenchmarkStringNamed(b *testing.B) {
+	x := into.Ptr(myString("hello"))
+	for i := 0; i < b.N; i++ {
+		want := "hello"
+		got := into.String(x)
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}
+
+func BenchmarkStringPointers(b *testing.B) {
+	x := into.Ptr(into.Ptr(myString("hello")))
+	for i := 0; i < b.N; i++ {
+		want := "hello"
+		got := into.String(x)
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}
+
 func BenchmarkStringFallback(b *testing.B) {
 	fallback := into.WithFallback("hello")
 	for i := 0; i < b.N; i++ {
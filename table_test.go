@@ -36,3 +36,26 @@ func (tab table[T]) Run(t *testing.T, do func(any, ...into.Option) T) {
 		})
 	}
 }
+
+// RunErr is like Run, but for the non-panicking variants of a coercer.
+func (tab table[T]) RunErr(t *testing.T, do func(any, ...into.Option) (T, error)) {
+	t.Helper()
+	t.Run("Err", func(t *testing.T) {
+		for _, test := range tab {
+			test := test
+			t.Run(test.name, func(t *testing.T) {
+				t.Helper()
+				got, err := do(test.input, test.opts...)
+				if err != nil && test.err == nil {
+					t.Fatal("unexpected error:", err)
+				}
+				if err == nil && test.err != nil {
+					t.Error("expected error, but did not see one")
+				}
+				if got != test.want {
+					t.Error("bad return value. want:", test.want, "got:", got)
+				}
+			})
+		}
+	})
+}
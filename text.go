@@ -0,0 +1,91 @@
+package into
+
+import (
+	"encoding"
+	"reflect"
+)
+
+// CanText returns true if the given value can be coerced to T by [Text].
+// [Text] will succeed without panicking if CanText returns true.
+func CanText[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](x any, options ...Option) bool {
+	_, err := text[T, PT](x, options)
+	return err == nil
+}
+
+// Text coerces x into T by unmarshaling its text, for any type T that implements [encoding.TextUnmarshaler]
+// through its pointer, such as UUIDs and decimals. It supports the following types:
+//   - T, *T
+//   - any string-like type supported by [String], passed to UnmarshalText
+//   - nil
+//
+// An empty string produces the fallback value.
+// Text will panic with ErrInvalid if the value cannot be coerced or UnmarshalText fails.
+func Text[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](x any, options ...Option) T {
+	v, err := text[T, PT](x, options)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// TextOK is like [Text], but reports whether the conversion succeeded instead of panicking.
+func TextOK[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](x any, options ...Option) (T, bool) {
+	v, err := text[T, PT](x, options)
+	return v, err == nil
+}
+
+// TextErr is like [Text], but returns an error instead of panicking.
+func TextErr[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](x any, options ...Option) (T, error) {
+	return text[T, PT](x, options)
+}
+
+func text[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](x any, options []Option) (T, error) {
+	var v T
+	switch x := x.(type) {
+	case T:
+		return x, nil
+	case *T:
+		if x == nil {
+			return fallbackFor[T](options, typeName[T]())
+		}
+		return *x, nil
+	case nil:
+		return fallbackFor[T](options, typeName[T]())
+	}
+
+	if !CanString(x, options...) {
+		return v, ErrInvalid{Value: x, Type: typeName[T]()}
+	}
+	str, err := stringFor(x, options)
+	if err != nil {
+		return v, err
+	}
+	if str == "" {
+		return fallbackFor[T](options, typeName[T]())
+	}
+	if err := PT(&v).UnmarshalText([]byte(str)); err != nil {
+		var zero T
+		return zero, ErrInvalid{Value: x, Type: typeName[T](), Cause: kindError{ErrSyntax, err}}
+	}
+	return v, nil
+}
+
+// typeName returns the name of T for error messages, such as "uuid.UUID".
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
@@ -0,0 +1,80 @@
+package into_test
+
+import (
+	"errors"
+	"math/big"
+	"net/netip"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestText(t *testing.T) {
+	t.Parallel()
+	want := netip.MustParseAddr("192.0.2.1")
+	tests := table[netip.Addr]{
+		{
+			name:  "string",
+			input: "192.0.2.1",
+			want:  want,
+		},
+		{
+			name:  "named string",
+			input: myString("192.0.2.1"),
+			want:  want,
+		},
+		{
+			name:  "[]byte",
+			input: []byte("192.0.2.1"),
+			want:  want,
+		},
+		{
+			name:  "same type",
+			input: want,
+			want:  want,
+		},
+		{
+			name:  "pointer",
+			input: &want,
+			want:  want,
+		},
+		{
+			name:  "nil",
+			input: nil,
+			want:  netip.Addr{},
+		},
+		{
+			name:  "empty with fallback",
+			input: "",
+			want:  want,
+			opts:  []into.Option{into.WithFallback(want)},
+		},
+		{
+			name:  "invalid",
+			input: "cat",
+			err:   into.ErrSyntax,
+		},
+		{
+			name:  "unsupported",
+			input: 42,
+			err:   into.ErrUnsupportedType,
+		},
+	}
+	tests.Run(t, into.Text[netip.Addr])
+	tests.RunErr(t, into.TextErr[netip.Addr])
+}
+
+func TestTextPointer(t *testing.T) {
+	t.Parallel()
+	n := into.Text[big.Int]("123456789012345678901234567890")
+	if n.String() != "123456789012345678901234567890" {
+		t.Error("bad result:", n.String())
+	}
+	if into.CanText[big.Int]("x") {
+		t.Error("succeeded but should have failed")
+	}
+	_, err := into.TextErr[big.Int](nil, into.WithFallback(1))
+	if !errors.Is(err, into.ErrBadFallback) {
+		t.Error("unexpected error:", err)
+	}
+}
@@ -1,24 +1,100 @@
 package into
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+)
 
 // Try executes fn, recovering and returning an error if it panics.
-// If the panic value satisfies the error interface, it will be returned as-is;
-// otherwise, it will be wrapped in [Panic].
+// This package's errors ([ErrInvalid], [Errors], and [ErrPath]) are returned as-is.
+// Other errors, such as runtime errors from nil map writes or out of range indexes,
+// are wrapped in [PanicError] to capture the location of the bug.
+// Any other panic value is wrapped in [Panic].
 func Try(fn func()) (err error) {
 	defer catch(&err)
 	fn()
 	return
 }
 
+// TryStrict is like [Try], but only recovers from this package's errors: [ErrInvalid], [Errors], and [ErrPath].
+// Anything else is re-panicked, so genuine bugs are not mistaken for coercion failures.
+func TryStrict(fn func()) (err error) {
+	defer catchStrict(&err)
+	fn()
+	return
+}
+
 func catch(ep *error) {
 	if ex := recover(); ex != nil {
-		err, ok := ex.(error)
-		if !ok {
-			err = Panic{Value: ex}
+		*ep = recovered(ex)
+	}
+}
+
+func catchStrict(ep *error) {
+	if ex := recover(); ex != nil {
+		if !isCoercionError(ex) {
+			panic(ex)
+		}
+		*ep = ex.(error)
+	}
+}
+
+func isCoercionError(ex any) bool {
+	err, ok := ex.(error)
+	if !ok || isPanic(err) {
+		return false
+	}
+	var invalid ErrInvalid
+	var path ErrPath
+	return errors.As(err, &invalid) || errors.As(err, &path)
+}
+
+// isPanic reports whether err is a recovered panic that is not a coercion failure, such as a runtime error.
+// Such errors must never be wrapped in ErrInvalid, so that [TryStrict] can tell them apart.
+func isPanic(err error) bool {
+	var p PanicError
+	var pv Panic
+	return errors.As(err, &p) || errors.As(err, &pv)
+}
+
+// recovered converts a recovered panic value into an error.
+// It must be called by the deferred function that called recover.
+func recovered(ex any) error {
+	err, ok := ex.(error)
+	switch {
+	case !ok:
+		return Panic{Value: ex, Stack: panicStack()}
+	case isPanic(err) || isCoercionError(err):
+		return err
+	}
+	return PanicError{Err: err, Stack: panicStack()}
+}
+
+// panicStack returns the program counters of the panicking goroutine, starting from the function that panicked.
+func panicStack() []uintptr {
+	pcs := make([]uintptr, 64)
+	// skip runtime.Callers, panicStack, recovered, and the deferred function
+	n := runtime.Callers(4, pcs)
+	pcs = pcs[:n]
+	// skip the runtime's panic machinery, such as runtime.gopanic and runtime.panicIndex
+	start := 0
+	for i := range pcs {
+		frame, _ := runtime.CallersFrames(pcs[i : i+1]).Next()
+		if frame.Function == "runtime.gopanic" {
+			start = i + 1
+			break
+		}
+	}
+	for start < len(pcs) {
+		frame, _ := runtime.CallersFrames(pcs[start : start+1]).Next()
+		if !strings.HasPrefix(frame.Function, "runtime.") {
+			break
 		}
-		*ep = err
+		start++
 	}
+	return pcs[start:]
 }
 
 // Maybe tries to run the given function (such as [Int] or [String]),
@@ -29,13 +105,70 @@ func Maybe[T any](try func(any, ...Option) T, value any, options ...Option) (res
 	return
 }
 
+// TryValue executes fn, returning its result, or recovering and returning an error if it panics.
+// Errors are handled the same as [Try].
+func TryValue[T any](fn func() T) (result T, err error) {
+	defer catch(&err)
+	result = fn()
+	return
+}
+
+// Try2 executes fn, returning its results, or recovering and returning an error if it panics.
+// Errors are handled the same as [Try].
+func Try2[T, U any](fn func() (T, U)) (result1 T, result2 U, err error) {
+	defer catch(&err)
+	result1, result2 = fn()
+	return
+}
+
+// Must returns v if err is nil, otherwise it panics with err,
+// the same way coercers such as [Int] panic with [ErrInvalid].
+// It is the inverse of [TryValue].
+func Must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // Panic is an error encapsulating a panicked value.
 type Panic struct {
 	// Value is the value passed to panic.
 	Value any
+	// Stack holds the program counters of the panicking goroutine, starting at the function that panicked.
+	// Use [Panic.Frames] to inspect them.
+	Stack []uintptr
 }
 
 // Error satisfies the error interface using fmt's %v verb to represent the panic value.
 func (p Panic) Error() string {
 	return fmt.Sprintf("panic: %v", p.Value)
 }
+
+// Frames returns the stack frames of the panic.
+func (p Panic) Frames() *runtime.Frames {
+	return runtime.CallersFrames(p.Stack)
+}
+
+// PanicError is an error encapsulating a panicked error other than this package's own,
+// such as a runtime error from an out of range index.
+type PanicError struct {
+	// Err is the value passed to panic.
+	Err error
+	// Stack holds the program counters of the panicking goroutine, starting at the function that panicked.
+	// Use [PanicError.Frames] to inspect them.
+	Stack []uintptr
+}
+
+func (p PanicError) Error() string {
+	return "panic: " + p.Err.Error()
+}
+
+func (p PanicError) Unwrap() error {
+	return p.Err
+}
+
+// Frames returns the stack frames of the panic.
+func (p PanicError) Frames() *runtime.Frames {
+	return runtime.CallersFrames(p.Stack)
+}
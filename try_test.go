@@ -1,7 +1,9 @@
 package into_test
 
 import (
+	"errors"
 	"fmt"
+	"runtime"
 	"strconv"
 	"strings"
 	"testing"
@@ -17,7 +19,22 @@ func TestTry(t *testing.T) {
 		got := into.Try(func() {
 			panic(want)
 		})
-		if want != got {
+		if !errors.Is(got, want) {
+			t.Error("bad error. want:", want, "got:", got)
+		}
+		var p into.PanicError
+		if !errors.As(got, &p) || len(p.Stack) == 0 {
+			t.Error("expected PanicError with a stack, got:", got)
+		}
+	})
+
+	t.Run("panic(ErrInvalid)", func(t *testing.T) {
+		t.Parallel()
+		want := into.ErrInvalid{Value: "x", Type: "int"}
+		got := into.Try(func() {
+			panic(want)
+		})
+		if got != want {
 			t.Error("bad error. want:", want, "got:", got)
 		}
 	})
@@ -28,7 +45,7 @@ func TestTry(t *testing.T) {
 		got := into.Try(func() {
 			panic(want.Value)
 		})
-		if want != got {
+		if p, ok := got.(into.Panic); !ok || p.Value != want.Value {
 			t.Error("bad error. want:", want, "got:", got)
 		}
 		if !strings.Contains(got.Error(), "panic:") {
@@ -44,6 +61,171 @@ func TestTry(t *testing.T) {
 	})
 }
 
+func TestTryStack(t *testing.T) {
+	t.Parallel()
+	t.Run("Panic", func(t *testing.T) {
+		err := into.Try(panicker)
+		var p into.Panic
+		if !errors.As(err, &p) {
+			t.Fatal("expected Panic, got:", err)
+		}
+		frame, _ := p.Frames().Next()
+		if !strings.HasSuffix(frame.Function, ".panicker") {
+			t.Error("stack should start at the panicking function, got:", frame.Function)
+		}
+	})
+
+	t.Run("runtime error", func(t *testing.T) {
+		err := into.Try(func() {
+			var m map[string]int
+			m["a"] = 1
+		})
+		var p into.PanicError
+		if !errors.As(err, &p) {
+			t.Fatal("expected PanicError, got:", err)
+		}
+		var rerr runtime.Error
+		if !errors.As(err, &rerr) {
+			t.Error("PanicError should unwrap to runtime.Error")
+		}
+		frame, _ := p.Frames().Next()
+		if !strings.Contains(frame.Function, "TestTryStack") {
+			t.Error("stack should start at the panicking function, got:", frame.Function)
+		}
+	})
+}
+
+type panicScanner struct{}
+
+func (*panicScanner) Scan(src any) error {
+	var m map[string]int
+	m["a"] = 1
+	return nil
+}
+
+func panicker() {
+	panic("oops")
+}
+
+func TestTryStrict(t *testing.T) {
+	t.Parallel()
+	err := into.TryStrict(func() {
+		into.Int("x")
+	})
+	var invalid into.ErrInvalid
+	if !errors.As(err, &invalid) {
+		t.Error("expected ErrInvalid, got:", err)
+	}
+
+	err = into.TryStrict(func() {
+		into.GetInt(map[string]any{}, "a.b")
+	})
+	var pathErr into.ErrPath
+	if !errors.As(err, &pathErr) {
+		t.Error("expected ErrPath, got:", err)
+	}
+
+	bugs := map[string]func(){
+		"Slice": func() {
+			into.Slice([]any{1}, func(x any, _ ...into.Option) int {
+				var m map[string]int
+				m["a"] = 1
+				return 0
+			})
+		},
+		"Collector": func() {
+			into.Attempt(into.Collect().At("a"), func(x any, _ ...into.Option) int {
+				var m map[string]int
+				m["a"] = 1
+				return 0
+			}, 1)
+		},
+		"Decode": func() {
+			var dst struct{ ID panicScanner }
+			into.Decode(map[string]any{"ID": 1}, &dst)
+		},
+	}
+	for name, bug := range bugs {
+		err = into.Try(func() {
+			into.TryStrict(bug)
+			t.Error(name, "TryStrict should have re-panicked")
+		})
+		var p into.PanicError
+		if !errors.As(err, &p) {
+			t.Error(name, "expected PanicError, got:", err)
+		}
+	}
+
+	for _, v := range []any{"oops", myError} {
+		err = into.Try(func() {
+			into.TryStrict(func() {
+				panic(v)
+			})
+			t.Error("TryStrict should have re-panicked")
+		})
+		if err == nil {
+			t.Error("expected panic to propagate for:", v)
+		}
+	}
+}
+
+func TestTryValue(t *testing.T) {
+	t.Parallel()
+	n, err := into.TryValue(func() int {
+		return into.Int("42", into.WithConvertStrings()) + 1
+	})
+	if err != nil || n != 43 {
+		t.Error("unexpected result:", n, err)
+	}
+
+	n, err = into.TryValue(func() int {
+		return into.Int("x")
+	})
+	var invalid into.ErrInvalid
+	if !errors.As(err, &invalid) || n != 0 {
+		t.Error("unexpected result:", n, err)
+	}
+}
+
+func TestTry2(t *testing.T) {
+	t.Parallel()
+	a, b, err := into.Try2(func() (int, string) {
+		return into.Int(1), into.String("b")
+	})
+	if err != nil || a != 1 || b != "b" {
+		t.Error("unexpected result:", a, b, err)
+	}
+
+	_, _, err = into.Try2(func() (int, string) {
+		return into.Int(1), into.String(2)
+	})
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestMust(t *testing.T) {
+	t.Parallel()
+	if got := into.Must(strconv.Atoi("42")); got != 42 {
+		t.Error("unexpected result:", got)
+	}
+	err := into.Try(func() {
+		into.Must(into.Maybe(into.Int, "x"))
+	})
+	var invalid into.ErrInvalid
+	if !errors.As(err, &invalid) {
+		t.Error("expected ErrInvalid, got:", err)
+	}
+}
+
+func ExampleMust() {
+	n := into.Must(into.TryValue(func() int {
+		return into.Int("42", into.WithConvertStrings())
+	}))
+	fmt.Println(n)
+	// Output: 42
+}
+
 func ExampleMaybe() {
 	_, err := into.Maybe(into.Int, "cat")
 	fmt.Println(err)
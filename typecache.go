@@ -0,0 +1,78 @@
+package into
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// A converter reads values of one particular type as T, following their pointers.
+// Like the kind helper it was compiled from (such as intKind), it reports false if the type does not hold a T.
+// It returns errNil if it reaches a nil pointer.
+type converter[T any] func(rv reflect.Value) (T, bool, error)
+
+// converterCache holds a converter for each type seen by a coercer's reflection fallback,
+// so that the pointers and kind of named types are worked out once per type instead of on every call.
+type converterCache[T any] struct {
+	kind  func(rv reflect.Value) (T, bool, error)
+	cache sync.Map // reflect.Type → *typeConverter[T]
+	// last is the most recently used entry, which is checked first
+	// because comparing types is much cheaper than hashing them.
+	last atomic.Pointer[typeConverter[T]]
+}
+
+type typeConverter[T any] struct {
+	typ  reflect.Type
+	conv converter[T]
+}
+
+func newConverterCache[T any](kind func(rv reflect.Value) (T, bool, error)) *converterCache[T] {
+	return &converterCache[T]{kind: kind}
+}
+
+// convert converts x, which must not be nil, with the converter for its type.
+func (c *converterCache[T]) convert(x any) (T, bool, error) {
+	rt := reflect.TypeOf(x)
+	tc := c.last.Load()
+	if tc == nil || tc.typ != rt {
+		tc = c.get(rt)
+		c.last.Store(tc)
+	}
+	return tc.conv(reflect.ValueOf(x))
+}
+
+// get returns the cache entry for rt, compiling its converter if necessary.
+func (c *converterCache[T]) get(rt reflect.Type) *typeConverter[T] {
+	tc, ok := c.cache.Load(rt)
+	if !ok {
+		tc, _ = c.cache.LoadOrStore(rt, &typeConverter[T]{typ: rt, conv: c.compile(rt)})
+	}
+	return tc.(*typeConverter[T])
+}
+
+// compile builds the converter for values of type rt.
+func (c *converterCache[T]) compile(rt reflect.Type) converter[T] {
+	depth := 0
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+		depth++
+	}
+	_, supported, _ := c.kind(reflect.Zero(rt))
+	return func(rv reflect.Value) (T, bool, error) {
+		var zero T
+		for i := 0; i < depth; i++ {
+			if rv.IsNil() {
+				return zero, false, errNil
+			}
+			rv = rv.Elem()
+		}
+		if !supported {
+			return zero, false, nil
+		}
+		return c.kind(rv)
+	}
+}
+
+// errNil is returned by the kind helpers (such as stringKind) for nil values, calling for the fallback value.
+var errNil = errors.New("into: nil value")
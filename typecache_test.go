@@ -0,0 +1,62 @@
+package into_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+type (
+	cacheInt8   int8
+	cacheInt64  int64
+	cacheUint16 uint16
+	cacheFloat  float32
+	cacheStruct struct{ N int }
+)
+
+func TestTypeCache(t *testing.T) {
+	t.Parallel()
+	var nilNamed *myInt
+	deep := into.Ptr(into.Ptr(into.Ptr(myInt(-7))))
+	// every case runs several times, so that later runs go through the cache
+	for i := 0; i < 3; i++ {
+		if got := into.Int(myInt(42)); got != 42 {
+			t.Error("named int:", got)
+		}
+		if got := into.Int(cacheInt8(-8)); got != -8 {
+			t.Error("named int8:", got)
+		}
+		if got := into.Int(cacheInt64(math.MinInt)); got != math.MinInt {
+			t.Error("named int64:", got)
+		}
+		if got := into.Int(deep); got != -7 {
+			t.Error("triple pointer:", got)
+		}
+		if got := into.Int(into.Ptr(nilNamed), into.WithFallback(1)); got != 1 {
+			t.Error("pointer to nil pointer:", got)
+		}
+		if got := into.Uint(into.Ptr(cacheUint16(16))); got != 16 {
+			t.Error("named uint16:", got)
+		}
+		if got := into.Float(into.Ptr(cacheFloat(0.5))); got != 0.5 {
+			t.Error("named float32:", got)
+		}
+		if got := into.String(into.Ptr(into.Ptr(myString("abc")))); got != "abc" {
+			t.Error("named string:", got)
+		}
+		if got := into.Bool(into.Ptr(myBool(true))); !got {
+			t.Error("named bool:", got)
+		}
+		if got := into.Int((*cacheStruct)(nil), into.WithFallback(2)); got != 2 {
+			t.Error("nil pointer to struct:", got)
+		}
+		if _, err := into.IntErr(myFloat(1)); !errors.Is(err, into.ErrUnsupportedType) {
+			t.Error("want ErrUnsupportedType for named float, got:", err)
+		}
+		if _, err := into.IntErr(cacheStruct{N: 1}); !errors.Is(err, into.ErrUnsupportedType) {
+			t.Error("want ErrUnsupportedType for struct, got:", err)
+		}
+	}
+}
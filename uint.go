@@ -91,57 +91,81 @@ func CanUint(x any, options ...Option) bool {
 //
 // Uint will panic with ErrInvalid if the value cannot be coerced.
 func Uint(x any, options ...Option) uint {
+	v, err := toUint(x, options)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// UintOK is like [Uint], but reports whether the conversion succeeded instead of panicking.
+func UintOK(x any, options ...Option) (uint, bool) {
+	v, err := toUint(x, options)
+	return v, err == nil
+}
+
+// UintErr is like [Uint], but returns an error instead of panicking.
+func UintErr(x any, options ...Option) (uint, error) {
+	return toUint(x, options)
+}
+
+func toUint(x any, options []Option) (uint, error) {
 	switch x := x.(type) {
 	case uint:
-		return x
+		return x, nil
 	case uint64:
-		return uint(x)
+		if uint64(uint(x)) != x {
+			return 0, ErrInvalid{Value: x, Type: "uint", Cause: ErrRange}
+		}
+		return uint(x), nil
 	case uint32:
-		return uint(x)
+		return uint(x), nil
 	case uint16:
-		return uint(x)
+		return uint(x), nil
 	case uint8:
-		return uint(x)
+		return uint(x), nil
 	case *uint:
 		if x == nil {
 			goto fallback
 		}
-		return *x
+		return *x, nil
 	case *uint64:
 		if x == nil {
 			goto fallback
 		}
-		return uint(*x)
+		if uint64(uint(*x)) != *x {
+			return 0, ErrInvalid{Value: x, Type: "uint", Cause: ErrRange}
+		}
+		return uint(*x), nil
 	case *uint32:
 		if x == nil {
 			goto fallback
 		}
-		return uint(*x)
+		return uint(*x), nil
 	case *uint16:
 		if x == nil {
 			goto fallback
 		}
-		return uint(*x)
+		return uint(*x), nil
 	case *uint8:
 		if x == nil {
 			goto fallback
 		}
-		return uint(*x)
+		return uint(*x), nil
 	case nil:
 		goto fallback
 	}
 
 	if !should(options, skipReflect) {
-		rv := reflect.ValueOf(x)
-		for rv.Kind() == reflect.Pointer {
-			if rv.IsNil() {
-				goto fallback
-			}
-			rv = rv.Elem()
+		n, ok, err := uintConverters.convert(x)
+		if err == errNil {
+			goto fallback
 		}
-		switch rv.Kind() {
-		case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
-			return uint(rv.Uint())
+		if ok {
+			if err != nil {
+				return 0, ErrInvalid{Value: x, Type: "uint", Cause: err}
+			}
+			return n, nil
 		}
 	}
 
@@ -154,27 +178,66 @@ func Uint(x any, options ...Option) uint {
 		// 	str = String(x)
 		// }
 
-		str := String(x)
+		str, err := toString(x, nil)
+		if err != nil {
+			return 0, err
+		}
 		if str == "" {
 			goto fallback
 		}
-		if n, err := strconv.ParseUint(str, 10, 64); err == nil {
-			return uint(n)
+		n, err := strconv.ParseUint(str, 10, strconv.IntSize)
+		if err != nil {
+			return 0, ErrInvalid{Value: x, Type: "uint", Cause: err}
 		}
+		return uint(n), nil
 	}
 
-	panic(ErrInvalid{Value: x, Type: "uint"})
+	return 0, ErrInvalid{Value: x, Type: "uint"}
 
 fallback:
-	var null uint
-	for _, opt := range options {
-		if opt, ok := opt.(fallbackValue); ok {
-			null, ok = opt.x.(uint)
-			if !ok {
-				panic(fmt.Errorf("invalid fallback value: %v (type of %T), must be %s", opt, opt, "int"))
-			}
-			break
+	return fallbackFor[uint](options, "uint")
+}
+
+// UintValue is like [Uint], but takes a reflect.Value such as a struct field.
+// Values with an underlying unsigned integer type are converted directly, without boxing them into an interface,
+// including unexported struct fields.
+func UintValue(rv reflect.Value, options ...Option) uint {
+	n, err := uintValue(rv, options)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func uintValue(rv reflect.Value, options []Option) (uint, error) {
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	rv, ok := indirect(rv)
+	if !ok {
+		return fallbackFor[uint](options, "uint")
+	}
+	if n, ok, err := uintKind(rv); ok {
+		if err != nil {
+			x, _ := valueOf(rv)
+			return 0, ErrInvalid{Value: x, Type: "uint", Cause: err}
 		}
+		return n, nil
+	}
+	return fromValue(rv, "uint", options, toUint)
+}
+
+var uintConverters = newConverterCache(uintKind)
+
+// uintKind converts rv if it has an underlying unsigned integer type, reporting false otherwise.
+func uintKind(rv reflect.Value) (uint, bool, error) {
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
+		n := rv.Uint()
+		if uint64(uint(n)) != n {
+			return 0, true, ErrRange
+		}
+		return uint(n), true, nil
 	}
-	return null
+	return 0, false, nil
 }
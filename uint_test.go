@@ -169,6 +169,7 @@ func TestUint(t *testing.T) {
 		},
 	}
 	tests.Run(t, into.Uint)
+	tests.RunErr(t, into.UintErr)
 }
 
 func TestCanUint(t *testing.T) {
@@ -294,6 +295,28 @@ func BenchmarkUintWithOptions(b *testing.B) {
 	}
 }
 
+func BenchmarkUintNamed(b *testing.B) {
+	x := myUint(42)
+	for i := 0; i < b.N; i++ {
+		var want uint = 42
+		got := into.Uint(x)
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}
+
+func BenchmarkUintPointers(b *testing.B) {
+	x := into.Ptr(into.Ptr(myUint(42)))
+	for i := 0; i < b.N; i++ {
+		want := uint(42)
+		got := into.Uint(x)
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}
+
 func BenchmarkUintFallback(b *testing.B) {
 	fallback := into.WithFallback(uint(42))
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,119 @@
+package into
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// CanURL returns true if the given value can be coerced to a URL.
+// [URL] will succeed without panicking if CanURL returns true.
+//
+// See: [URL] for supported types.
+func CanURL(x any, options ...Option) bool {
+	_, err := toURL(x, options)
+	return err == nil
+}
+
+// URL coerces x into a URL, supporting the following types:
+//   - *url.URL, url.URL
+//   - any string-like type supported by [String]
+//   - nil
+//
+// Use [WithAbsoluteURL] and [WithSchemes] to constrain the result.
+// The default fallback value is a nil *url.URL.
+//
+// URL will panic with ErrInvalid if the value cannot be coerced.
+func URL(x any, options ...Option) *url.URL {
+	u, err := toURL(x, options)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// URLOK is like [URL], but reports whether the conversion succeeded instead of panicking.
+func URLOK(x any, options ...Option) (*url.URL, bool) {
+	v, err := toURL(x, options)
+	return v, err == nil
+}
+
+// URLErr is like [URL], but returns an error instead of panicking.
+func URLErr(x any, options ...Option) (*url.URL, error) {
+	return toURL(x, options)
+}
+
+// URLValue is like [URL], but takes a reflect.Value such as a struct field.
+func URLValue(rv reflect.Value, options ...Option) *url.URL {
+	v, err := fromValue(rv, "url", options, toURL)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func toURL(x any, options []Option) (*url.URL, error) {
+	var u *url.URL
+	switch v := x.(type) {
+	case *url.URL:
+		if v == nil {
+			return fallbackFor[*url.URL](options, "*url.URL")
+		}
+		u = v
+	case url.URL:
+		u = &v
+	case nil:
+		return fallbackFor[*url.URL](options, "*url.URL")
+	default:
+		if !CanString(x, options...) {
+			return nil, ErrInvalid{Value: x, Type: "url"}
+		}
+		str, err := stringFor(x, options)
+		if err != nil {
+			return nil, err
+		}
+		if str == "" {
+			return fallbackFor[*url.URL](options, "*url.URL")
+		}
+		u, err = url.Parse(str)
+		if err != nil {
+			return nil, ErrInvalid{Value: x, Type: "url", Cause: kindError{ErrSyntax, err}}
+		}
+	}
+
+	if should(options, absoluteURL) && !u.IsAbs() {
+		return nil, ErrInvalid{Value: x, Type: "url", Cause: errNotAbsolute}
+	}
+	for _, opt := range options {
+		if schemes, ok := opt.(allowedSchemes); ok && !schemes.allows(u.Scheme) {
+			return nil, ErrInvalid{Value: x, Type: "url", Cause: fmt.Errorf("%w: scheme %q not allowed", ErrRange, u.Scheme)}
+		}
+	}
+	return u, nil
+}
+
+var errNotAbsolute = fmt.Errorf("%w: not an absolute URL", ErrRange)
+
+type allowedSchemes []string
+
+func (allowedSchemes) isOption() {}
+
+func (schemes allowedSchemes) allows(scheme string) bool {
+	for _, s := range schemes {
+		if strings.EqualFold(s, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithAbsoluteURL requires [URL] to produce an absolute URL (one with a scheme).
+func WithAbsoluteURL() Option {
+	return absoluteURL
+}
+
+// WithSchemes restricts [URL] to the given schemes, compared case-insensitively.
+func WithSchemes(schemes ...string) Option {
+	return allowedSchemes(schemes)
+}
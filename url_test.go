@@ -0,0 +1,108 @@
+package into_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+func TestURL(t *testing.T) {
+	t.Parallel()
+	const href = "https://example.com/hook?a=1"
+	parsed, _ := url.Parse(href)
+	tests := table[string]{
+		{
+			name:  "string",
+			input: href,
+			want:  href,
+		},
+		{
+			name:  "*url.URL",
+			input: parsed,
+			want:  href,
+		},
+		{
+			name:  "url.URL",
+			input: *parsed,
+			want:  href,
+		},
+		{
+			name:  "string subtype",
+			input: myString(href),
+			want:  href,
+		},
+		{
+			name:  "relative",
+			input: "/hook",
+			want:  "/hook",
+		},
+		{
+			name:  "relative but absolute required",
+			input: "/hook",
+			opts:  []into.Option{into.WithAbsoluteURL()},
+			err:   into.ErrInvalid{Value: "/hook", Type: "url"},
+		},
+		{
+			name:  "allowed scheme",
+			input: href,
+			want:  href,
+			opts:  []into.Option{into.WithSchemes("http", "HTTPS")},
+		},
+		{
+			name:  "disallowed scheme",
+			input: "ftp://example.com",
+			opts:  []into.Option{into.WithSchemes("https")},
+			err:   into.ErrInvalid{Value: "ftp://example.com", Type: "url"},
+		},
+		{
+			name:  "bad string",
+			input: "http://[::1",
+			err:   into.ErrInvalid{Value: "http://[::1", Type: "url"},
+		},
+		{
+			name:  "fallback",
+			input: nil,
+			want:  href,
+			opts:  []into.Option{into.WithFallback(parsed)},
+		},
+		{
+			name:  "invalid type",
+			input: 42,
+			err:   into.ErrInvalid{Value: 42, Type: "url"},
+		},
+	}
+	tests.Run(t, func(x any, options ...into.Option) string {
+		u := into.URL(x, options...)
+		if u == nil {
+			return ""
+		}
+		return u.String()
+	})
+}
+
+func TestURLCause(t *testing.T) {
+	t.Parallel()
+	_, err := into.Maybe(into.URL, "http://[::1")
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		t.Error("expected *url.Error cause, got:", err)
+	}
+	if into.URL(nil) != nil {
+		t.Error("expected nil default fallback")
+	}
+}
+
+func TestCanURL(t *testing.T) {
+	t.Parallel()
+	if !into.CanURL("https://example.com", into.WithAbsoluteURL(), into.WithSchemes("https")) {
+		t.Error("failed but should have succeeded")
+	}
+	if into.CanURL("http://example.com", into.WithSchemes("https")) {
+		t.Error("succeeded but should have failed")
+	}
+	if into.CanURL(struct{}{}) {
+		t.Error("succeeded but should have failed")
+	}
+}
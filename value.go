@@ -0,0 +1,57 @@
+package into
+
+import "reflect"
+
+// indirect dereferences the pointers of rv, reporting false if it reaches a nil pointer or an invalid value.
+func indirect(rv reflect.Value) (reflect.Value, bool) {
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return rv, false
+		}
+		rv = rv.Elem()
+	}
+	return rv, rv.IsValid()
+}
+
+// valueOf returns rv as an interface for the coercers, unwrapping interface values.
+// Values that cannot be interfaced, such as unexported struct fields,
+// are returned as plain strings if they are string-like, or as copies if they are numbers or bools,
+// and are otherwise reported as false.
+func valueOf(rv reflect.Value) (any, bool) {
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	switch {
+	case !rv.IsValid():
+		return nil, true
+	case rv.CanInterface():
+		return rv.Interface(), true
+	}
+	rv, ok := indirect(rv)
+	if !ok {
+		return nil, true
+	}
+	if str, ok, err := stringKind(rv); ok {
+		if err == errNil {
+			return nil, true
+		}
+		return str, true
+	}
+	if v := scalarValue(rv); v.kind != reflect.Invalid {
+		cp := reflect.New(rv.Type()).Elem()
+		if v.set(cp) == nil {
+			return cp.Interface(), true
+		}
+	}
+	return nil, false
+}
+
+// fromValue runs conv on rv as an interface, for coercers without reflection fallbacks.
+func fromValue[T any](rv reflect.Value, typ string, options []Option, conv func(any, []Option) (T, error)) (T, error) {
+	x, ok := valueOf(rv)
+	if !ok {
+		var zero T
+		return zero, ErrInvalid{Type: typ}
+	}
+	return conv(x, options)
+}
@@ -0,0 +1,141 @@
+package into_test
+
+import (
+	"errors"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/guregu/into"
+)
+
+type valueFields struct {
+	Count  myInt
+	Temp   *myFloat
+	Ptr    **int
+	Name   myString
+	Any    any
+	Nil    any
+	Addr   string
+	Marsh  myMarshaler
+	count  int16
+	name   []byte
+	nilPtr *uint
+	big    uint64
+	state  status
+	object struct{}
+}
+
+func TestReflectValue(t *testing.T) {
+	t.Parallel()
+	n := 7
+	pn := &n
+	rv := reflect.ValueOf(valueFields{
+		Count: 42,
+		Temp:  into.Ptr(myFloat(36.5)),
+		Ptr:   &pn,
+		Name:  "cat",
+		Any:   int32(3),
+		Addr:  "192.0.2.1",
+		Marsh: myMarshaler{},
+		count: -1,
+		name:  []byte("12"),
+		big:   1 << 31,
+		state: statusActive,
+	})
+	field := rv.FieldByName
+
+	if got := into.IntValue(field("Count")); got != 42 {
+		t.Error("bad Count:", got)
+	}
+	if got := into.IntValue(field("Ptr")); got != 7 {
+		t.Error("bad Ptr:", got)
+	}
+	if got := into.FloatValue(field("Temp")); got != 36.5 {
+		t.Error("bad Temp:", got)
+	}
+	if got := into.IntValue(field("Any")); got != 3 {
+		t.Error("bad Any:", got)
+	}
+	if got := into.IntValue(field("Nil"), into.WithFallback(1)); got != 1 {
+		t.Error("bad Nil:", got)
+	}
+	if got := into.StringValue(field("Name")); got != "cat" {
+		t.Error("bad Name:", got)
+	}
+	if got := into.StringValue(field("Marsh")); got != "hello" {
+		t.Error("bad Marsh:", got)
+	}
+	if got := into.AddrValue(field("Addr"), into.WithConvertStrings()); got != netip.MustParseAddr("192.0.2.1") {
+		t.Error("bad Addr:", got)
+	}
+
+	t.Run("unexported", func(t *testing.T) {
+		if got := into.IntValue(field("count")); got != -1 {
+			t.Error("bad count:", got)
+		}
+		if got := into.StringValue(field("name")); got != "12" {
+			t.Error("bad name:", got)
+		}
+		if got := into.UintValue(field("name"), into.WithConvertStrings()); got != 12 {
+			t.Error("bad name as uint:", got)
+		}
+		if got := into.UintValue(field("nilPtr"), into.WithFallback(uint(5))); got != 5 {
+			t.Error("bad nilPtr:", got)
+		}
+		if got := into.UintValue(field("big")); got != 1<<31 {
+			t.Error("bad big:", got)
+		}
+		if got := into.EnumValue(field("state"), statusNames); got != statusActive {
+			t.Error("bad state:", got)
+		}
+		err := into.Try(func() {
+			into.IntValue(field("object"))
+		})
+		if !errors.Is(err, into.ErrUnsupportedType) {
+			t.Error("unexpected error:", err)
+		}
+	})
+
+	t.Run("unexported errors", func(t *testing.T) {
+		tests := map[string]func(){
+			"IntValue":    func() { into.IntValue(field("object")) },
+			"EnumValue":   func() { into.EnumValue(field("object"), statusNames) },
+			"AddrValue":   func() { into.AddrValue(field("object")) },
+			"PrefixValue": func() { into.PrefixValue(field("object")) },
+			"URLValue":    func() { into.URLValue(field("object")) },
+		}
+		for name, do := range tests {
+			var invalid into.ErrInvalid
+			if err := into.Try(do); !errors.As(err, &invalid) {
+				t.Error(name, "unexpected error:", err)
+				continue
+			}
+			if _, ok := invalid.Value.(reflect.Value); ok {
+				t.Error(name, "error value is a reflect.Value:", invalid)
+			}
+		}
+	})
+}
+
+func BenchmarkIntValue(b *testing.B) {
+	rv := reflect.ValueOf(struct{ N myInt }{42}).Field(0)
+	for i := 0; i < b.N; i++ {
+		want := 42
+		got := into.IntValue(rv)
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}
+
+func BenchmarkIntInterface(b *testing.B) {
+	rv := reflect.ValueOf(struct{ N myInt }{42}).Field(0)
+	for i := 0; i < b.N; i++ {
+		want := 42
+		got := into.Int(rv.Interface())
+		if want != got {
+			b.Fatal("bad result. want:", want, "got:", got)
+		}
+	}
+}